@@ -2,74 +2,152 @@ package axonopsClient
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var axonops_api_version = "api/v1"
 
-// debugLog prints debug information if AXONOPS_DEBUG environment variable is set
-func debugLog(format string, args ...interface{}) {
-	if os.Getenv("AXONOPS_DEBUG") != "" {
-		fmt.Printf("[AXONOPS DEBUG] "+format+"\n", args...)
+// debugLog prints debug information to stderr when debugging is enabled. Stdout carries the
+// plugin protocol, so nothing may be printed there.
+func (c *AxonopsHttpClient) debugLog(format string, args ...interface{}) {
+	if !c.debug {
+		return
 	}
+	fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] "+format+"\n", args...)
 }
 
-// debugRequest logs request details for debugging
-func debugRequest(req *http.Request, body []byte) {
-	if os.Getenv("AXONOPS_DEBUG") == "" {
+// debugRequest prints request details to stderr when debugging is enabled
+func (c *AxonopsHttpClient) debugRequest(req *http.Request, body []byte) {
+	if !c.debug {
 		return
 	}
-	fmt.Printf("[AXONOPS DEBUG] === REQUEST ===\n")
-	fmt.Printf("[AXONOPS DEBUG] Method: %s\n", req.Method)
-	fmt.Printf("[AXONOPS DEBUG] URL: %s\n", req.URL.String())
-	fmt.Printf("[AXONOPS DEBUG] Headers:\n")
+	fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] === REQUEST ===\n")
+	fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] Method: %s\n", req.Method)
+	fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] URL: %s\n", req.URL.String())
+	fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] Headers:\n")
 	for key, values := range req.Header {
 		for _, value := range values {
-			// Mask API key for security
-			if key == "Authorization" {
-				if len(value) > 20 {
-					fmt.Printf("[AXONOPS DEBUG]   %s: %s...%s\n", key, value[:15], value[len(value)-4:])
-				} else {
-					fmt.Printf("[AXONOPS DEBUG]   %s: %s\n", key, value)
-				}
-			} else {
-				fmt.Printf("[AXONOPS DEBUG]   %s: %s\n", key, value)
-			}
+			// Mask API key and proxy credentials for security
+			fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG]   %s: %s\n", key, maskHeaderValue(key, value))
 		}
 	}
 	if body != nil && len(body) > 0 {
-		fmt.Printf("[AXONOPS DEBUG] Body: %s\n", string(body))
+		fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] Body: %s\n", string(body))
 	}
 }
 
-// debugResponse logs response details for debugging
-func debugResponse(resp *http.Response, body []byte) {
-	if os.Getenv("AXONOPS_DEBUG") == "" {
+// debugResponse prints response details to stderr when debugging is enabled
+func (c *AxonopsHttpClient) debugResponse(resp *http.Response, body []byte) {
+	if !c.debug {
 		return
 	}
-	fmt.Printf("[AXONOPS DEBUG] === RESPONSE ===\n")
-	fmt.Printf("[AXONOPS DEBUG] Status: %d %s\n", resp.StatusCode, resp.Status)
-	fmt.Printf("[AXONOPS DEBUG] Headers:\n")
+	fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] === RESPONSE ===\n")
+	fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] Status: %d %s\n", resp.StatusCode, resp.Status)
+	fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] Headers:\n")
 	for key, values := range resp.Header {
 		for _, value := range values {
-			fmt.Printf("[AXONOPS DEBUG]   %s: %s\n", key, value)
+			fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG]   %s: %s\n", key, value)
 		}
 	}
 	if body != nil && len(body) > 0 {
 		// Truncate long responses
 		bodyStr := string(body)
 		if len(bodyStr) > 500 {
-			fmt.Printf("[AXONOPS DEBUG] Body (truncated): %s...\n", bodyStr[:500])
+			fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] Body (truncated): %s...\n", bodyStr[:500])
 		} else {
-			fmt.Printf("[AXONOPS DEBUG] Body: %s\n", bodyStr)
+			fmt.Fprintf(os.Stderr, "[AXONOPS DEBUG] Body: %s\n", bodyStr)
+		}
+	}
+}
+
+// maskHeaderValue masks the API key and proxy credentials for security
+func maskHeaderValue(key, value string) string {
+	if (key == "Authorization" || key == "Proxy-Authorization") && len(value) > 20 {
+		return value[:15] + "..." + value[len(value)-4:]
+	}
+	return value
+}
+
+// debugHeaders returns the headers as log fields with credentials masked
+func debugHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for key, values := range header {
+		masked := make([]string, len(values))
+		for i, value := range values {
+			masked[i] = maskHeaderValue(key, value)
+		}
+		headers[key] = strings.Join(masked, ", ")
+	}
+	return headers
+}
+
+// logDebug logs with tflog once SetDebug was called, otherwise it falls back to debugLog
+func (c *AxonopsHttpClient) logDebug(ctx context.Context, format string, args ...interface{}) {
+	if !c.useTflog {
+		c.debugLog(format, args...)
+		return
+	}
+	if c.debug {
+		tflog.Debug(ctx, fmt.Sprintf(format, args...))
+	}
+}
+
+// logRequest logs request details with tflog once SetDebug was called, otherwise it falls back to debugRequest
+func (c *AxonopsHttpClient) logRequest(req *http.Request, body []byte) {
+	if !c.useTflog {
+		c.debugRequest(req, body)
+		return
+	}
+	if !c.debug {
+		return
+	}
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": debugHeaders(req.Header),
+	}
+	if len(body) > 0 {
+		fields["body"] = string(body)
+	}
+	tflog.Debug(req.Context(), "AxonOps API request", fields)
+}
+
+// logResponse logs response details with tflog once SetDebug was called, otherwise it falls back to debugResponse
+func (c *AxonopsHttpClient) logResponse(resp *http.Response, body []byte) {
+	if !c.useTflog {
+		c.debugResponse(resp, body)
+		return
+	}
+	if !c.debug {
+		return
+	}
+	fields := map[string]interface{}{
+		"status":  resp.StatusCode,
+		"url":     resp.Request.URL.String(),
+		"headers": debugHeaders(resp.Header),
+	}
+	if len(body) > 0 {
+		// Truncate long responses
+		bodyStr := string(body)
+		if len(bodyStr) > 500 {
+			bodyStr = bodyStr[:500] + "..."
 		}
+		fields["body"] = bodyStr
 	}
+	tflog.Debug(resp.Request.Context(), "AxonOps API response", fields)
 }
 
 type AxonopsHttpClient struct {
@@ -79,19 +157,205 @@ type AxonopsHttpClient struct {
 	apiKey      string
 	orgid       string
 	tokenType   string
+
+	// Retry policy for transient failures, see doWithRetry
+	maxRetries      int
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+
+	// Whether requests and responses are logged. It starts out set from the AXONOPS_DEBUG
+	// environment variable and is replaced by the provider's resolved setting in SetDebug.
+	debug bool
+	// Set by SetDebug, false when debug output is printed to stderr
+	useTflog bool
+}
+
+// SetDebug sets whether requests and responses are logged, and logs them with tflog using
+// the logger in the request context, so they are part of Terraform's log stream and filtered
+// by TF_LOG. Without it, debug output goes to stderr when AXONOPS_DEBUG is set, which is meant
+// for using the client outside of Terraform.
+func (c *AxonopsHttpClient) SetDebug(enabled bool) {
+	c.debug = enabled
+	c.useTflog = true
+}
+
+// ProxyConfig routes API requests through an HTTP proxy. An empty URL means the proxy is
+// taken from the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+type ProxyConfig struct {
+	URL      string
+	Username string
+	Password string
+}
+
+// ConnectionConfig sizes the connection pool used for API requests. Applies run many
+// resource operations in parallel, which the default of 2 idle connections per host can't serve.
+type ConnectionConfig struct {
+	MaxConnections    int           // idle connections kept per host and in total
+	IdleConnTimeout   time.Duration // how long an idle connection is kept open
+	DisableKeepAlives bool          // open a new connection for every request
+}
+
+// TLSConfig holds the certificates used for mutual TLS with on-premises installations.
+// Empty paths fall back to the system defaults.
+type TLSConfig struct {
+	ClientCertFile     string
+	ClientKeyFile      string
+	CACertFile         string
+	InsecureSkipVerify bool
+}
+
+// buildTLSConfig loads the configured certificates, or returns nil when none are configured
+func buildTLSConfig(config TLSConfig) (*tls.Config, error) {
+	if config.ClientCertFile == "" && config.ClientKeyFile == "" && config.CACertFile == "" && !config.InsecureSkipVerify {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.ClientCertFile != "" || config.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if config.CACertFile != "" {
+		caCert, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no PEM certificates found in %s", config.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
 }
 
-func CreateHTTPClient(protocol, axonopsHost, apiKey, orgid, tokenType string) *AxonopsHttpClient {
+// CreateHTTPClient returns a client for the AxonOps API. timeout bounds each request,
+// including reading the response body.
+func CreateHTTPClient(protocol, axonopsHost, apiKey, orgid, tokenType string, timeout time.Duration, proxy ProxyConfig, tlsSettings TLSConfig, connection ConnectionConfig) (*AxonopsHttpClient, error) {
+	tlsConfig, err := buildTLSConfig(tlsSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.MaxIdleConns = connection.MaxConnections
+	transport.MaxIdleConnsPerHost = connection.MaxConnections
+	transport.IdleConnTimeout = connection.IdleConnTimeout
+	transport.DisableKeepAlives = connection.DisableKeepAlives
+
+	if proxy.URL != "" {
+		proxyUrl, err := url.Parse(proxy.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+
+		// Credentials on the proxy URL are sent as Proxy-Authorization, both on
+		// plain requests and on the CONNECT used to tunnel HTTPS
+		if proxy.Username != "" {
+			proxyUrl.User = url.UserPassword(proxy.Username, proxy.Password)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	} else if proxy.Username != "" {
+		// Add the credentials to the proxy from the environment, unless its URL has its own
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			proxyUrl, err := http.ProxyFromEnvironment(req)
+			if proxyUrl != nil && proxyUrl.User == nil {
+				proxyUrl.User = url.UserPassword(proxy.Username, proxy.Password)
+			}
+			return proxyUrl, err
+		}
+	}
 
 	return &AxonopsHttpClient{
 		protocol:    protocol,
 		axonopsHost: axonopsHost,
 		apiKey:      apiKey,
 		client: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout:   timeout,
+			Transport: transport,
 		},
-		orgid:     orgid,
-		tokenType: tokenType,
+		orgid:           orgid,
+		tokenType:       tokenType,
+		maxRetries:      3,
+		retryBackoff:    1 * time.Second,
+		maxRetryBackoff: 30 * time.Second,
+		debug:           os.Getenv("AXONOPS_DEBUG") != "",
+	}, nil
+}
+
+// isRetryableStatus reports whether a status code indicates a transient server-side failure
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case 429, 500, 502, 503, 504:
+		return true
+	}
+	return false
+}
+
+// doWithRetry sends req and returns the response together with its body, which has already
+// been read and closed. A status in expectedStatuses is returned immediately; transport errors
+// and 429/5xx responses are retried up to maxRetries times with exponential backoff starting
+// at backoff and capped at the client's maxRetryBackoff. The request body is rebuilt from body
+// on every attempt since the original reader is consumed by the first send.
+//
+// GET, DELETE and PUT call sites pass c.maxRetries. POST is not idempotent, so POST call sites
+// pass 0 unless repeating the call is known to be safe.
+func (c *AxonopsHttpClient) doWithRetry(req *http.Request, body []byte, expectedStatuses []int, maxRetries int, backoff time.Duration) (*http.Response, []byte, error) {
+	for attempt := 0; ; attempt++ {
+		if body != nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			req.ContentLength = int64(len(body))
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			if attempt >= maxRetries {
+				return nil, nil, err
+			}
+			c.logDebug(req.Context(), "%s %s failed (%v), retrying in %s", req.Method, req.URL.String(), err, backoff)
+		} else {
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			c.logResponse(resp, respBody)
+
+			expected := false
+			for _, status := range expectedStatuses {
+				if resp.StatusCode == status {
+					expected = true
+					break
+				}
+			}
+			if expected || !isRetryableStatus(resp.StatusCode) || attempt >= maxRetries {
+				return resp, respBody, nil
+			}
+
+			// Honour Retry-After when the server tells us how long to wait
+			if seconds, convErr := strconv.Atoi(resp.Header.Get("Retry-After")); convErr == nil && seconds > 0 {
+				backoff = time.Duration(seconds) * time.Second
+			}
+			c.logDebug(req.Context(), "%s %s returned status %d, retrying in %s", req.Method, req.URL.String(), resp.StatusCode, backoff)
+		}
+
+		if backoff > c.maxRetryBackoff {
+			backoff = c.maxRetryBackoff
+		}
+		// Stop waiting when Terraform is interrupted
+		select {
+		case <-req.Context().Done():
+			return nil, nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
 	}
 }
 
@@ -119,7 +383,46 @@ type KafkaTopicConfig struct {
 	Value string `json:"value"`
 }
 
-func (c *AxonopsHttpClient) CreateTopic(topicName, clusterName string, partitionCount, replicationFactor int32, topicConfigs []KafkaTopicConfig) error {
+// TopicConfigKeyMap maps the Terraform config map keys of the known Kafka topic configs
+// to their Kafka names. Terraform users write the keys with underscores in place of dots.
+var TopicConfigKeyMap = map[string]string{
+	"cleanup_policy":                          "cleanup.policy",
+	"compression_gzip_level":                  "compression.gzip.level",
+	"compression_lz4_level":                   "compression.lz4.level",
+	"compression_type":                        "compression.type",
+	"compression_zstd_level":                  "compression.zstd.level",
+	"delete_retention_ms":                     "delete.retention.ms",
+	"file_delete_delay_ms":                    "file.delete.delay.ms",
+	"flush_messages":                          "flush.messages",
+	"flush_ms":                                "flush.ms",
+	"follower_replication_throttled_replicas": "follower.replication.throttled.replicas",
+	"index_interval_bytes":                    "index.interval.bytes",
+	"leader_replication_throttled_replicas":   "leader.replication.throttled.replicas",
+	"local_retention_bytes":                   "local.retention.bytes",
+	"local_retention_ms":                      "local.retention.ms",
+	"max_compaction_lag_ms":                   "max.compaction.lag.ms",
+	"max_message_bytes":                       "max.message.bytes",
+	"message_downconversion_enable":           "message.downconversion.enable",
+	"message_format_version":                  "message.format.version",
+	"message_timestamp_after_max_ms":          "message.timestamp.after.max.ms",
+	"message_timestamp_before_max_ms":         "message.timestamp.before.max.ms",
+	"message_timestamp_difference_max_ms":     "message.timestamp.difference.max.ms",
+	"message_timestamp_type":                  "message.timestamp.type",
+	"min_cleanable_dirty_ratio":               "min.cleanable.dirty.ratio",
+	"min_compaction_lag_ms":                   "min.compaction.lag.ms",
+	"min_insync_replicas":                     "min.insync.replicas",
+	"preallocate":                             "preallocate",
+	"remote_storage_enable":                   "remote.storage.enable",
+	"retention_bytes":                         "retention.bytes",
+	"retention_ms":                            "retention.ms",
+	"segment_bytes":                           "segment.bytes",
+	"segment_index_bytes":                     "segment.index.bytes",
+	"segment_jitter_ms":                       "segment.jitter.ms",
+	"segment_ms":                              "segment.ms",
+	"unclean_leader_election_enable":          "unclean.leader.election.enable",
+}
+
+func (c *AxonopsHttpClient) CreateTopic(ctx context.Context, topicName, clusterName string, partitionCount, replicationFactor int32, topicConfigs []KafkaTopicConfig) error {
 
 	payload := KafkaTopic{
 		TopicName:         topicName,
@@ -135,7 +438,7 @@ func (c *AxonopsHttpClient) CreateTopic(topicName, clusterName string, partition
 
 	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/topics", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
 		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
 	}
@@ -146,18 +449,13 @@ func (c *AxonopsHttpClient) CreateTopic(topicName, clusterName string, partition
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, payloadJson)
+	c.logRequest(req, payloadJson)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{201}, 0, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send POST request: %w", err)
 	}
 
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
-
 	if resp.StatusCode == 201 {
 		return nil
 	} else {
@@ -194,11 +492,11 @@ type TopicConfigResponse struct {
 }
 
 // GetTopic retrieves a topic's information including configs
-func (c *AxonopsHttpClient) GetTopic(topicName, clusterName string) (*TopicInfo, error) {
+func (c *AxonopsHttpClient) GetTopic(ctx context.Context, topicName, clusterName string) (*TopicInfo, error) {
 	// Get basic topic info
 	topicUrl := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/topics/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, topicName)
 
-	req, err := http.NewRequest("GET", topicUrl, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", topicUrl, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, topicUrl)
 	}
@@ -207,16 +505,16 @@ func (c *AxonopsHttpClient) GetTopic(topicName, clusterName string) (*TopicInfo,
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
+	if resp.StatusCode == 404 {
+		return nil, nil // Topic doesn't exist
+	}
 
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("failed to get topic: status %d for url %v, body: %s", resp.StatusCode, topicUrl, string(bodyBytes))
@@ -228,9 +526,19 @@ func (c *AxonopsHttpClient) GetTopic(topicName, clusterName string) (*TopicInfo,
 	}
 
 	// Get topic configs
+	topicInfo.Config, err = c.GetTopicConfigs(ctx, topicName, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &topicInfo, nil
+}
+
+// GetTopicConfigs retrieves the explicitly set configs of a topic
+func (c *AxonopsHttpClient) GetTopicConfigs(ctx context.Context, topicName, clusterName string) ([]KafkaTopicConfig, error) {
 	configUrl := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/topics/%s/configs", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, topicName)
 
-	configReq, err := http.NewRequest("GET", configUrl, nil)
+	configReq, err := http.NewRequestWithContext(ctx, "GET", configUrl, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request for configs: %w", err)
 	}
@@ -239,15 +547,15 @@ func (c *AxonopsHttpClient) GetTopic(topicName, clusterName string) (*TopicInfo,
 		configReq.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	configResp, err := c.client.Do(configReq)
+	configResp, bodyBytes, err := c.doWithRetry(configReq, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request for configs: %w", err)
 	}
-	defer configResp.Body.Close()
 
+	var configs []KafkaTopicConfig
 	if configResp.StatusCode == 200 {
 		var configResponse TopicConfigResponse
-		if err := json.NewDecoder(configResp.Body).Decode(&configResponse); err != nil {
+		if err := json.Unmarshal(bodyBytes, &configResponse); err != nil {
 			return nil, fmt.Errorf("failed to decode configs response: %w", err)
 		}
 
@@ -255,7 +563,7 @@ func (c *AxonopsHttpClient) GetTopic(topicName, clusterName string) (*TopicInfo,
 		if len(configResponse.TopicDescription) > 0 {
 			for _, entry := range configResponse.TopicDescription[0].ConfigEntries {
 				if entry.IsExplicitlySet {
-					topicInfo.Config = append(topicInfo.Config, KafkaTopicConfig{
+					configs = append(configs, KafkaTopicConfig{
 						Name:  entry.Name,
 						Value: entry.Value,
 					})
@@ -264,14 +572,14 @@ func (c *AxonopsHttpClient) GetTopic(topicName, clusterName string) (*TopicInfo,
 		}
 	}
 
-	return &topicInfo, nil
+	return configs, nil
 }
 
 // GetTopics retrieves all topics for a cluster
-func (c *AxonopsHttpClient) GetTopics(clusterName string) ([]TopicInfo, error) {
+func (c *AxonopsHttpClient) GetTopics(ctx context.Context, clusterName string) ([]TopicInfo, error) {
 	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/topics", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
@@ -280,29 +588,28 @@ func (c *AxonopsHttpClient) GetTopics(clusterName string) ([]TopicInfo, error) {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("failed to get topics: status %d for url %v", resp.StatusCode, url)
 	}
 
 	var topics []TopicInfo
-	if err := json.NewDecoder(resp.Body).Decode(&topics); err != nil {
+	if err := json.Unmarshal(bodyBytes, &topics); err != nil {
 		return nil, fmt.Errorf("failed to decode topics response: %w", err)
 	}
 
 	return topics, nil
 }
 
-func (c *AxonopsHttpClient) DeleteTopic(topicName, clusterName string) error {
+func (c *AxonopsHttpClient) DeleteTopic(ctx context.Context, topicName, clusterName string) error {
 
 	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/topics/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, topicName)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
 	}
@@ -312,11 +619,10 @@ func (c *AxonopsHttpClient) DeleteTopic(topicName, clusterName string) error {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	resp, _, err := c.doWithRetry(req, nil, []int{204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send DELETE request: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode == 204 {
 		return nil
@@ -335,7 +641,7 @@ type KafkaUpdateTopicConfig struct {
 	Op    string `json:"op"`
 }
 
-func (c *AxonopsHttpClient) UpdateTopicConfig(topicName, clusterName string, partitionCount, replicationFactor int32, topicConfigs []KafkaUpdateTopicConfig) error {
+func (c *AxonopsHttpClient) UpdateTopicConfig(ctx context.Context, topicName, clusterName string, partitionCount, replicationFactor int32, topicConfigs []KafkaUpdateTopicConfig) error {
 
 	payload := ConfigsWrapper{
 		Configs: topicConfigs,
@@ -348,7 +654,7 @@ func (c *AxonopsHttpClient) UpdateTopicConfig(topicName, clusterName string, par
 
 	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/topics/%s/configs", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, topicName)
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
 		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
 	}
@@ -359,11 +665,10 @@ func (c *AxonopsHttpClient) UpdateTopicConfig(topicName, clusterName string, par
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	resp, _, err := c.doWithRetry(req, payloadJson, []int{204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send PUT request: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode == 204 {
 		return nil
@@ -372,571 +677,2106 @@ func (c *AxonopsHttpClient) UpdateTopicConfig(topicName, clusterName string, par
 	}
 }
 
-// ACL types and methods
-
-type KafkaACL struct {
-	ResourceType        string `json:"resourceType"`
-	ResourceName        string `json:"resourceName"`
-	ResourcePatternType string `json:"resourcePatternType"`
-	Principal           string `json:"principal"`
-	Host                string `json:"host"`
-	Operation           string `json:"operation"`
-	PermissionType      string `json:"permissionType"`
-}
-
-type ACLResource struct {
-	ResourceType        string     `json:"resourceType"`
-	ResourceName        string     `json:"resourceName"`
-	ResourcePatternType string     `json:"resourcePatternType"`
-	ACLs                []KafkaACL `json:"acls"`
+type IncreasePartitionsRequest struct {
+	PartitionCount int32 `json:"partitionCount"`
 }
 
-type ACLResponse struct {
-	ACLResources []ACLResource `json:"aclResources"`
-}
+// IncreaseTopicPartitions raises the partition count of a topic. Kafka can't reduce partitions.
+func (c *AxonopsHttpClient) IncreaseTopicPartitions(ctx context.Context, topicName, clusterName string, newCount int32) error {
+	payloadJson, err := json.Marshal(IncreasePartitionsRequest{PartitionCount: newCount})
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
 
-func (c *AxonopsHttpClient) GetACLs(clusterName string) (*ACLResponse, error) {
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/acls", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/topics/%s/partitions", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, topicName)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+		return fmt.Errorf("failed to create PATCH request: %w for url %v", err, url)
 	}
 
+	req.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, payloadJson)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send GET request: %w", err)
+		return fmt.Errorf("failed to send PATCH request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	body, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, body)
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get ACLs: status %d, body: %s", resp.StatusCode, string(body))
-	}
-
-	var result ACLResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to decode ACL response: %w", err)
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to increase topic partitions: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
-
-	return &result, nil
 }
 
-func (c *AxonopsHttpClient) CreateACL(clusterName string, acl KafkaACL) error {
-	payloadJson, err := json.Marshal(acl)
-	if err != nil {
-		return fmt.Errorf("failed to encode JSON payload: %w", err)
-	}
-
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/acls", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+// Ping checks the API is reachable and accepts the configured credentials.
+// Servers without the info endpoint answer 404 once the request is authenticated,
+// which is good enough to validate the credentials.
+func (c *AxonopsHttpClient) Ping(ctx context.Context) error {
+	url := fmt.Sprintf("%s://%s/%s/%s/info", c.protocol, c.axonopsHost, axonops_api_version, c.orgid)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+		return fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return fmt.Errorf("failed to send POST request: %w", err)
+		return fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+	switch resp.StatusCode {
+	case 200, 404:
 		return nil
-	} else {
-		return fmt.Errorf("failed to create ACL: status %d for url %v with acl:%+v", resp.StatusCode, url, acl)
+	case 401, 403:
+		return fmt.Errorf("authentication failed: status %d for url %v, check api_key, token_type and org_id", resp.StatusCode, url)
+	default:
+		return fmt.Errorf("unexpected response: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-func (c *AxonopsHttpClient) DeleteACL(clusterName string, acl KafkaACL) error {
-	payloadJson, err := json.Marshal(acl)
-	if err != nil {
-		return fmt.Errorf("failed to encode JSON payload: %w", err)
-	}
+// Cluster version types and methods
 
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/acls", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+// KafkaVersion describes the Kafka version running on a cluster
+type KafkaVersion struct {
+	BrokerVersion           string `json:"brokerVersion"`
+	ProtocolVersion         string `json:"protocolVersion"`
+	LogMessageFormatVersion string `json:"logMessageFormatVersion"`
+	KRaftMode               bool   `json:"kraftMode"`
+}
+
+func (c *AxonopsHttpClient) GetKafkaVersion(ctx context.Context, clusterName string) (*KafkaVersion, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/version", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-	req, err := http.NewRequest("DELETE", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return fmt.Errorf("failed to send DELETE request: %w", err)
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == 204 {
-		return nil
-	} else {
-		return fmt.Errorf("failed to delete ACL: status %d for url %v with acl:%+v", resp.StatusCode, url, acl)
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get kafka version: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
-}
 
-// Kafka Connect Connector types and methods
+	var version KafkaVersion
+	if err := json.Unmarshal(bodyBytes, &version); err != nil {
+		return nil, fmt.Errorf("failed to decode kafka version response: %w", err)
+	}
 
-type KafkaConnector struct {
-	Name   string            `json:"name"`
-	Config map[string]string `json:"config"`
+	return &version, nil
 }
 
-type KafkaConnectorConfig struct {
-	Config map[string]string `json:"config"`
-}
+// Broker types and methods
 
-type KafkaConnectorResponse struct {
-	Name   string            `json:"name"`
-	Config map[string]string `json:"config"`
-	Tasks  []ConnectorTask   `json:"tasks"`
-	Type   string            `json:"type"`
+type KafkaBroker struct {
+	ID   int    `json:"id"`
+	Host string `json:"host"`
+	Port int    `json:"port"`
+	Rack string `json:"rack"`
 }
 
-type ConnectorTask struct {
-	Connector string `json:"connector"`
-	Task      int    `json:"task"`
+// KafkaClusterInfo describes the brokers of a cluster and which one is the controller
+type KafkaClusterInfo struct {
+	KafkaVersion string        `json:"kafkaVersion"`
+	ControllerID int           `json:"controllerId"`
+	Brokers      []KafkaBroker `json:"brokers"`
 }
 
-// ConnectorsListResponse represents the response from the connectors list endpoint
-type ConnectorsListResponse struct {
-	ClusterName    string                          `json:"clusterName"`
-	ClusterAddress string                          `json:"clusterAddress"`
-	Connectors     map[string]ConnectorListEntry   `json:"connectors"`
+// BrokerConfigDescription wraps config entries for a broker, which share the topic entry format
+type BrokerConfigDescription struct {
+	BrokerID      int                `json:"brokerId"`
+	ConfigEntries []TopicConfigEntry `json:"configEntries"`
 }
 
-type ConnectorListEntry struct {
-	Info   KafkaConnectorResponse `json:"info"`
-	Status ConnectorStatus        `json:"status"`
+// BrokerConfigResponse is the response from the broker configs endpoint
+type BrokerConfigResponse struct {
+	BrokerDescription []BrokerConfigDescription `json:"brokerDescription"`
 }
 
-type ConnectorStatus struct {
-	Name      string                 `json:"name"`
-	Connector ConnectorStateInfo     `json:"connector"`
-	Tasks     []ConnectorTaskStatus  `json:"tasks"`
-	Type      string                 `json:"type"`
-}
+// GetBrokers retrieves the brokers of a cluster
+func (c *AxonopsHttpClient) GetBrokers(ctx context.Context, clusterName string) ([]KafkaBroker, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/brokers", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-type ConnectorStateInfo struct {
-	State    string `json:"state"`
-	WorkerId string `json:"worker_id"`
-}
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
 
-type ConnectorTaskStatus struct {
-	Id       int    `json:"id"`
-	State    string `json:"state"`
-	WorkerId string `json:"worker_id"`
-	Trace    string `json:"trace"`
-}
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
 
-func (c *AxonopsHttpClient) CreateConnector(clusterName, connectClusterName string, connector KafkaConnector) (*KafkaConnectorResponse, error) {
-	payloadJson, err := json.Marshal(connector)
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode JSON payload: %w", err)
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
 
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/connector", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName)
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get brokers: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	var brokers []KafkaBroker
+	if err := json.Unmarshal(bodyBytes, &brokers); err != nil {
+		return nil, fmt.Errorf("failed to decode brokers response: %w", err)
+	}
+
+	return brokers, nil
+}
+
+// GetKafkaClusterInfo retrieves the topology of a cluster
+func (c *AxonopsHttpClient) GetKafkaClusterInfo(ctx context.Context, clusterName string) (*KafkaClusterInfo, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/info", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, payloadJson)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send POST request: %w", err)
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get cluster info: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
 
-	if resp.StatusCode == 200 || resp.StatusCode == 201 {
-		var result KafkaConnectorResponse
-		if err := json.Unmarshal(bodyBytes, &result); err != nil {
-			return nil, fmt.Errorf("failed to decode response: %w", err)
-		}
-		return &result, nil
-	} else {
-		return nil, fmt.Errorf("failed to create connector: status %d for url %v with connector:%+v, body: %s", resp.StatusCode, url, connector, string(bodyBytes))
+	var info KafkaClusterInfo
+	if err := json.Unmarshal(bodyBytes, &info); err != nil {
+		return nil, fmt.Errorf("failed to decode cluster info response: %w", err)
 	}
+
+	return &info, nil
 }
 
-func (c *AxonopsHttpClient) GetConnector(clusterName, connectClusterName, connectorName string) (*KafkaConnectorResponse, error) {
-	// Use the connectors list endpoint and filter for the specific connector
-	// The single connector GET endpoint has known issues with AxonOps API
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/connectors", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName)
+// GetBrokerConfigEntries retrieves all config entries of a broker, including defaults
+func (c *AxonopsHttpClient) GetBrokerConfigEntries(ctx context.Context, clusterName string, brokerID int) ([]TopicConfigEntry, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/brokers/%d/configs", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, brokerID)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
 
-	// Set headers
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get broker configs: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
 
-	if resp.StatusCode == 200 {
-		var result ConnectorsListResponse
-		if err := json.Unmarshal(bodyBytes, &result); err != nil {
-			return nil, fmt.Errorf("failed to decode response: %w", err)
-		}
+	var configResponse BrokerConfigResponse
+	if err := json.Unmarshal(bodyBytes, &configResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode broker configs response: %w", err)
+	}
 
-		// Find the specific connector in the map
-		if connector, exists := result.Connectors[connectorName]; exists {
-			return &connector.Info, nil
-		}
-		return nil, nil // Connector not found
-	} else if resp.StatusCode == 404 {
-		return nil, nil // Connect cluster or connector not found
-	} else {
-		return nil, fmt.Errorf("failed to get connector: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	if len(configResponse.BrokerDescription) == 0 {
+		return nil, nil
 	}
+	return configResponse.BrokerDescription[0].ConfigEntries, nil
 }
 
-func (c *AxonopsHttpClient) UpdateConnectorConfig(clusterName, connectClusterName, connectorName string, config map[string]string) (*KafkaConnectorResponse, error) {
-	payload := KafkaConnectorConfig{
-		Config: config,
+// GetBrokerConfig retrieves all configs of a broker as a map
+func (c *AxonopsHttpClient) GetBrokerConfig(ctx context.Context, clusterName string, brokerID int) (map[string]string, error) {
+	entries, err := c.GetBrokerConfigEntries(ctx, clusterName, brokerID)
+	if err != nil {
+		return nil, err
+	}
+
+	config := make(map[string]string)
+	for _, entry := range entries {
+		config[entry.Name] = entry.Value
+	}
+	return config, nil
+}
+
+// GetBrokerConfigs retrieves the cluster-wide dynamic broker configs, leaving out static and default values
+func (c *AxonopsHttpClient) GetBrokerConfigs(ctx context.Context, clusterName string) (map[string]string, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/brokers/configs", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get broker configs: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	var configResponse BrokerConfigResponse
+	if err := json.Unmarshal(bodyBytes, &configResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode broker configs response: %w", err)
+	}
+
+	config := make(map[string]string)
+	for _, description := range configResponse.BrokerDescription {
+		for _, entry := range description.ConfigEntries {
+			if entry.IsExplicitlySet {
+				config[entry.Name] = entry.Value
+			}
+		}
+	}
+	return config, nil
+}
+
+// UpdateBrokerConfig sets or deletes cluster-wide dynamic broker configs
+func (c *AxonopsHttpClient) UpdateBrokerConfig(ctx context.Context, clusterName string, configs []KafkaUpdateTopicConfig) error {
+	payload := ConfigsWrapper{
+		Configs: configs,
 	}
 
 	payloadJson, err := json.Marshal(payload)
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode JSON payload: %w", err)
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/%s/config", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName, connectorName)
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/brokers/configs", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
 	}
 
-	// Set headers
 	req.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, payloadJson)
+	c.logRequest(req, payloadJson)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send PUT request: %w", err)
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("failed to update broker configs: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// Consumer group types and methods
+
+// ConsumerGroupPartition identifies a topic partition assigned to a consumer
+type ConsumerGroupPartition struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+}
+
+type ConsumerGroupMember struct {
+	MemberID           string                   `json:"memberId"`
+	ClientID           string                   `json:"clientId"`
+	Host               string                   `json:"host"`
+	AssignedPartitions []ConsumerGroupPartition `json:"assignedPartitions"`
+}
+
+// ConsumerGroupOffset is the committed position of a group on one partition
+type ConsumerGroupOffset struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+	Lag       int64  `json:"lag"`
+}
+
+type ConsumerGroup struct {
+	GroupID string                `json:"groupId"`
+	State   string                `json:"state"`
+	Members []ConsumerGroupMember `json:"members"`
+	Offsets []ConsumerGroupOffset `json:"offsets"`
+}
+
+// GetConsumerGroup returns the members and lag of a consumer group, or nil if it doesn't exist
+func (c *AxonopsHttpClient) GetConsumerGroup(ctx context.Context, clusterName, groupId string) (*ConsumerGroup, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/consumer-groups/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, groupId)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
 
 	if resp.StatusCode == 200 {
-		var result KafkaConnectorResponse
+		var result ConsumerGroup
 		if err := json.Unmarshal(bodyBytes, &result); err != nil {
 			return nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 		return &result, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil
 	} else {
-		return nil, fmt.Errorf("failed to update connector config: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+		return nil, fmt.Errorf("failed to get consumer group: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-func (c *AxonopsHttpClient) DeleteConnector(clusterName, connectClusterName, connectorName string) error {
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName, connectorName)
+// GetConsumerGroups lists the consumer groups in a cluster
+func (c *AxonopsHttpClient) GetConsumerGroups(ctx context.Context, clusterName string) ([]ConsumerGroup, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/consumer-groups", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
 
-	// Set headers
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return fmt.Errorf("failed to send DELETE request: %w", err)
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
-
-	if resp.StatusCode == 204 || resp.StatusCode == 200 {
-		return nil
-	} else {
-		return fmt.Errorf("failed to delete connector: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get consumer groups: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
-}
-
-// Schema Registry types and methods
-
-type SchemaReference struct {
-	Name    string `json:"name"`
-	Subject string `json:"subject"`
-	Version int    `json:"version"`
-}
 
-type CreateSchemaRequest struct {
-	Schema     string            `json:"schema"`
-	SchemaType string            `json:"schemaType"`
-	References []SchemaReference `json:"references,omitempty"`
-}
+	var groups []ConsumerGroup
+	if err := json.Unmarshal(bodyBytes, &groups); err != nil {
+		return nil, fmt.Errorf("failed to decode consumer groups response: %w", err)
+	}
 
-type CreateSchemaResponse struct {
-	Id int `json:"id"`
+	return groups, nil
 }
 
-type SchemaRegistryVersionedSchema struct {
-	Id            int               `json:"id"`
-	Version       int               `json:"version"`
-	Schema        string            `json:"schema"`
-	Type          string            `json:"type"`
-	References    []SchemaReference `json:"references"`
-	IsSoftDeleted bool              `json:"isSoftDeleted"`
+// ConsumerGroupOffsetReset moves the committed offsets of a group on a topic
+type ConsumerGroupOffsetReset struct {
+	Topic     string `json:"topic"`
+	ResetType string `json:"resetType"`
+	Value     string `json:"value,omitempty"`
 }
 
-func (c *AxonopsHttpClient) CreateSchema(clusterName, subject string, schema CreateSchemaRequest) (*CreateSchemaResponse, error) {
-	payloadJson, err := json.Marshal(schema)
+// ResetConsumerGroupOffset resets the offsets of a consumer group on a topic. The group must have no
+// active members.
+func (c *AxonopsHttpClient) ResetConsumerGroupOffset(ctx context.Context, clusterName, groupId string, reset ConsumerGroupOffsetReset) error {
+	payloadJson, err := json.Marshal(reset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode JSON payload: %w", err)
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject)
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/consumer-groups/%s/offsets/reset", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, groupId)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
 	}
 
-	// Set headers
 	req.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, 0, c.retryBackoff)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send POST request: %w", err)
+		return fmt.Errorf("failed to send POST request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == 200 || resp.StatusCode == 201 {
-		var result CreateSchemaResponse
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			return nil, fmt.Errorf("failed to decode response: %w", err)
-		}
-		return &result, nil
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
 	} else {
-		return nil, fmt.Errorf("failed to create schema: status %d for url %v", resp.StatusCode, url)
+		return fmt.Errorf("failed to reset consumer group offsets: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-func (c *AxonopsHttpClient) GetSchema(clusterName, subject string, version string) (*SchemaRegistryVersionedSchema, error) {
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject, version)
+// ConsumerGroupConfigResponse is the response from the consumer group configs endpoint
+type ConsumerGroupConfigResponse struct {
+	ConfigEntries []TopicConfigEntry `json:"configEntries"`
+}
+
+func (c *AxonopsHttpClient) consumerGroupConfigUrl(clusterName, groupId string) string {
+	return fmt.Sprintf("%s://%s/%s/%s/kafka/%s/consumer-groups/%s/configs", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, groupId)
+}
+
+// GetConsumerGroupConfig returns the configs explicitly set on a consumer group
+func (c *AxonopsHttpClient) GetConsumerGroupConfig(ctx context.Context, clusterName, groupId string) (map[string]string, error) {
+	url := c.consumerGroupConfigUrl(clusterName, groupId)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
 
-	// Set headers
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == 200 {
-		var result SchemaRegistryVersionedSchema
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			return nil, fmt.Errorf("failed to decode response: %w", err)
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get consumer group configs: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	var configResponse ConsumerGroupConfigResponse
+	if err := json.Unmarshal(bodyBytes, &configResponse); err != nil {
+		return nil, fmt.Errorf("failed to decode consumer group configs response: %w", err)
+	}
+
+	config := make(map[string]string)
+	for _, entry := range configResponse.ConfigEntries {
+		if entry.IsExplicitlySet {
+			config[entry.Name] = entry.Value
 		}
-		return &result, nil
-	} else if resp.StatusCode == 404 {
-		return nil, nil // Schema not found
-	} else {
-		return nil, fmt.Errorf("failed to get schema: status %d for url %v", resp.StatusCode, url)
 	}
+	return config, nil
 }
 
-func (c *AxonopsHttpClient) DeleteSchema(clusterName, subject string) error {
-	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject)
+// UpdateConsumerGroupConfig sets or deletes configs of a consumer group
+func (c *AxonopsHttpClient) UpdateConsumerGroupConfig(ctx context.Context, clusterName, groupId string, configs []KafkaUpdateTopicConfig) error {
+	payload := ConfigsWrapper{
+		Configs: configs,
+	}
+
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := c.consumerGroupConfigUrl(clusterName, groupId)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	if resp.StatusCode != 204 {
+		return fmt.Errorf("failed to update consumer group configs: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// DeleteConsumerGroupConfig resets all configs of a consumer group to the defaults
+func (c *AxonopsHttpClient) DeleteConsumerGroupConfig(ctx context.Context, clusterName, groupId string) error {
+	url := c.consumerGroupConfigUrl(clusterName, groupId)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
 	}
 
-	// Set headers
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204, 404}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send DELETE request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+	// 404 means the group is already gone
+	if resp.StatusCode == 200 || resp.StatusCode == 204 || resp.StatusCode == 404 {
 		return nil
 	} else {
-		return fmt.Errorf("failed to delete schema: status %d for url %v", resp.StatusCode, url)
+		return fmt.Errorf("failed to delete consumer group configs: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-// Log Collector types and methods
+// Cluster policy types and methods
 
-type LogCollectorConfig struct {
-	Name               string   `json:"name"`
-	UUID               string   `json:"uuid"`
-	Filename           string   `json:"filename"`
-	DateFormat         string   `json:"dateFormat"`
-	InfoRegex          string   `json:"infoRegex"`
-	WarningRegex       string   `json:"warningRegex"`
-	ErrorRegex         string   `json:"errorRegex"`
-	DebugRegex         string   `json:"debugRegex"`
-	SupportedAgentType []string `json:"supportedAgentType"`
-	ErrorAlertThreshold int     `json:"errorAlertThreshold,omitempty"`
+// KafkaClusterPolicy holds cluster-wide governance rules for topics
+type KafkaClusterPolicy struct {
+	PreventTopicDeletion bool     `json:"preventTopicDeletion"`
+	RequireConfigKeys    []string `json:"requireConfigKeys"`
+	MaxPartitionCount    int      `json:"maxPartitionCount"`
+	MinReplicationFactor int      `json:"minReplicationFactor"`
 }
 
-func (c *AxonopsHttpClient) GetLogCollectors(clusterName string) ([]LogCollectorConfig, error) {
-	url := fmt.Sprintf("%s://%s/api/v1/logcollectors/%s/kafka/%s", c.protocol, c.axonopsHost, c.orgid, clusterName)
+func (c *AxonopsHttpClient) GetKafkaClusterPolicy(ctx context.Context, clusterName string) (*KafkaClusterPolicy, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/policy", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
 
-	// Set headers
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode == 200 {
-		var result []LogCollectorConfig
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		var result KafkaClusterPolicy
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
 			return nil, fmt.Errorf("failed to decode response: %w", err)
 		}
-		return result, nil
+		return &result, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil // No policy set on the cluster
 	} else {
-		return nil, fmt.Errorf("failed to get log collectors: status %d for url %v", resp.StatusCode, url)
+		return nil, fmt.Errorf("failed to get cluster policy: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-func (c *AxonopsHttpClient) UpdateLogCollectors(clusterName string, collectors []LogCollectorConfig) error {
-	collectorsJson, err := json.Marshal(collectors)
+func (c *AxonopsHttpClient) UpdateKafkaClusterPolicy(ctx context.Context, clusterName string, policy KafkaClusterPolicy) error {
+	payloadJson, err := json.Marshal(policy)
 	if err != nil {
 		return fmt.Errorf("failed to encode JSON payload: %w", err)
 	}
 
-	reqUrl := fmt.Sprintf("%s://%s/api/v1/logcollectors/%s/kafka/%s", c.protocol, c.axonopsHost, c.orgid, clusterName)
-
-	// The API expects form-urlencoded data with addlogs parameter
-	// URL-encode the JSON to properly handle special characters
-	formData := "addlogs=" + url.QueryEscape(string(collectorsJson))
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/policy", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-	req, err := http.NewRequest("PUT", reqUrl, bytes.NewBufferString(formData))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
-		return fmt.Errorf("failed to create PUT request: %w for url %v", err, reqUrl)
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
 	}
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send PUT request: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode == 200 || resp.StatusCode == 204 {
 		return nil
 	} else {
-		return fmt.Errorf("failed to update log collectors: status %d for url %v", resp.StatusCode, reqUrl)
+		return fmt.Errorf("failed to update cluster policy: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-// Healthcheck types and methods
+func (c *AxonopsHttpClient) DeleteKafkaClusterPolicy(ctx context.Context, clusterName string) error {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/policy", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
 
-type HealthcheckIntegrations struct {
-	Type            string   `json:"Type"`
-	Routing         []string `json:"Routing"`
-	OverrideInfo    bool     `json:"OverrideInfo"`
-	OverrideWarning bool     `json:"OverrideWarning"`
-	OverrideError   bool     `json:"OverrideError"`
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 || resp.StatusCode == 404 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to delete cluster policy: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
 }
 
-type ShellHealthcheck struct {
-	ID           string                  `json:"id"`
-	Name         string                  `json:"name"`
-	Interval     string                  `json:"interval"`
-	Timeout      string                  `json:"timeout"`
-	Integrations HealthcheckIntegrations `json:"integrations"`
-	Readonly     bool                    `json:"readonly"`
-	Shell        string                  `json:"shell"`
-	Script       string                  `json:"script"`
+// ACL types and methods
+
+type KafkaACL struct {
+	ResourceType        string `json:"resourceType"`
+	ResourceName        string `json:"resourceName"`
+	ResourcePatternType string `json:"resourcePatternType"`
+	Principal           string `json:"principal"`
+	Host                string `json:"host"`
+	Operation           string `json:"operation"`
+	PermissionType      string `json:"permissionType"`
 }
 
-type HTTPHealthcheck struct {
-	ID                 string                  `json:"id"`
-	Name               string                  `json:"name"`
-	Interval           string                  `json:"interval"`
-	Timeout            string                  `json:"timeout"`
+type ACLResource struct {
+	ResourceType        string     `json:"resourceType"`
+	ResourceName        string     `json:"resourceName"`
+	ResourcePatternType string     `json:"resourcePatternType"`
+	ACLs                []KafkaACL `json:"acls"`
+}
+
+type ACLResponse struct {
+	ACLResources []ACLResource `json:"aclResources"`
+}
+
+func (c *AxonopsHttpClient) GetACLs(ctx context.Context, clusterName string) (*ACLResponse, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/acls", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, body, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get ACLs: status %d, body: %s", resp.StatusCode, string(body))
+	}
+
+	var result ACLResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode ACL response: %w", err)
+	}
+
+	return &result, nil
+}
+
+func (c *AxonopsHttpClient) CreateACL(ctx context.Context, clusterName string, acl KafkaACL) error {
+	payloadJson, err := json.Marshal(acl)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/acls", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	resp, _, err := c.doWithRetry(req, payloadJson, []int{200, 204}, 0, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to create ACL: status %d for url %v with acl:%+v", resp.StatusCode, url, acl)
+	}
+}
+
+func (c *AxonopsHttpClient) DeleteACL(ctx context.Context, clusterName string, acl KafkaACL) error {
+	payloadJson, err := json.Marshal(acl)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/acls", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	resp, _, err := c.doWithRetry(req, payloadJson, []int{204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to delete ACL: status %d for url %v with acl:%+v", resp.StatusCode, url, acl)
+	}
+}
+
+// Kafka Quota types and methods
+
+type KafkaQuota struct {
+	EntityType        string   `json:"entityType"`
+	EntityName        string   `json:"entityName"`
+	ProducerByteRate  *float64 `json:"producerByteRate,omitempty"`
+	ConsumerByteRate  *float64 `json:"consumerByteRate,omitempty"`
+	RequestPercentage *float64 `json:"requestPercentage,omitempty"`
+}
+
+// kafkaQuotaUrl returns the quotas endpoint, or the entity's when entityType is set
+func (c *AxonopsHttpClient) kafkaQuotaUrl(clusterName, entityType, entityName string) string {
+	quotaUrl := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/quotas", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+	if entityType != "" {
+		quotaUrl += "/" + url.PathEscape(entityType) + "/" + url.PathEscape(entityName)
+	}
+	return quotaUrl
+}
+
+// GetQuota returns the quota of an entity, or nil if no quota is set
+func (c *AxonopsHttpClient) GetQuota(ctx context.Context, clusterName, entityType, entityName string) (*KafkaQuota, error) {
+	url := c.kafkaQuotaUrl(clusterName, entityType, entityName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result KafkaQuota
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil
+	} else {
+		return nil, fmt.Errorf("failed to get quota: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+func (c *AxonopsHttpClient) CreateQuota(ctx context.Context, clusterName string, quota KafkaQuota) error {
+	payloadJson, err := json.Marshal(quota)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := c.kafkaQuotaUrl(clusterName, "", "")
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 201, 204}, 0, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 201 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to create quota: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// UpdateQuota replaces the limits of an existing quota, unset limits are removed
+func (c *AxonopsHttpClient) UpdateQuota(ctx context.Context, clusterName string, quota KafkaQuota) error {
+	payloadJson, err := json.Marshal(quota)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := c.kafkaQuotaUrl(clusterName, quota.EntityType, quota.EntityName)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to update quota: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+func (c *AxonopsHttpClient) DeleteQuota(ctx context.Context, clusterName, entityType, entityName string) error {
+	url := c.kafkaQuotaUrl(clusterName, entityType, entityName)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 || resp.StatusCode == 404 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to delete quota: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// Kafka Connect Connector types and methods
+
+type KafkaConnector struct {
+	Name   string            `json:"name"`
+	Config map[string]string `json:"config"`
+}
+
+type KafkaConnectorConfig struct {
+	Config map[string]string `json:"config"`
+}
+
+type KafkaConnectorResponse struct {
+	Name             string            `json:"name"`
+	Config           map[string]string `json:"config"`
+	Tasks            []ConnectorTask   `json:"tasks"`
+	Type             string            `json:"type"`
+	LastConfigUpdate string            `json:"lastConfigUpdate,omitempty"`
+}
+
+// FilterConnectorConfig returns the config of a connector without the "name" key, which
+// Kafka Connect adds to every connector config and isn't part of the configured values
+func FilterConnectorConfig(config map[string]string) map[string]string {
+	filtered := make(map[string]string, len(config))
+	for key, value := range config {
+		if key == "name" {
+			continue
+		}
+		filtered[key] = value
+	}
+	return filtered
+}
+
+type ConnectorTask struct {
+	Connector string `json:"connector"`
+	Task      int    `json:"task"`
+}
+
+// ConnectorsListResponse represents the response from the connectors list endpoint
+type ConnectorsListResponse struct {
+	ClusterName    string                          `json:"clusterName"`
+	ClusterAddress string                          `json:"clusterAddress"`
+	Connectors     map[string]ConnectorListEntry   `json:"connectors"`
+}
+
+type ConnectorListEntry struct {
+	Info   KafkaConnectorResponse `json:"info"`
+	Status ConnectorStatus        `json:"status"`
+}
+
+type ConnectorStatus struct {
+	Name      string                 `json:"name"`
+	Connector ConnectorStateInfo     `json:"connector"`
+	Tasks     []ConnectorTaskStatus  `json:"tasks"`
+	Type      string                 `json:"type"`
+}
+
+type ConnectorStateInfo struct {
+	State    string `json:"state"`
+	WorkerId string `json:"worker_id"`
+}
+
+type ConnectorTaskStatus struct {
+	Id       int    `json:"id"`
+	State    string `json:"state"`
+	WorkerId string `json:"worker_id"`
+	Trace    string `json:"trace"`
+}
+
+// KafkaConnectCluster is a Kafka Connect cluster attached to a Kafka cluster
+type KafkaConnectCluster struct {
+	ClusterName    string `json:"clusterName"`
+	ClusterAddress string `json:"clusterAddress"`
+}
+
+// GetConnectClusters returns the names of the Kafka Connect clusters of a Kafka cluster
+func (c *AxonopsHttpClient) GetConnectClusters(ctx context.Context, clusterName string) ([]string, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get connect clusters: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	var connectClusters []KafkaConnectCluster
+	if err := json.Unmarshal(bodyBytes, &connectClusters); err != nil {
+		return nil, fmt.Errorf("failed to decode connect clusters response: %w", err)
+	}
+
+	names := []string{}
+	for _, cc := range connectClusters {
+		names = append(names, cc.ClusterName)
+	}
+	return names, nil
+}
+
+func (c *AxonopsHttpClient) CreateConnector(ctx context.Context, clusterName, connectClusterName string, connector KafkaConnector) (*KafkaConnectorResponse, error) {
+	payloadJson, err := json.Marshal(connector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/connector", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 201}, 0, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 201 {
+		var result KafkaConnectorResponse
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else {
+		return nil, fmt.Errorf("failed to create connector: status %d for url %v with connector:%+v, body: %s", resp.StatusCode, url, connector, string(bodyBytes))
+	}
+}
+
+func (c *AxonopsHttpClient) GetConnector(ctx context.Context, clusterName, connectClusterName, connectorName string) (*KafkaConnectorResponse, error) {
+	entry, err := c.getConnectorEntry(ctx, clusterName, connectClusterName, connectorName)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return &entry.Info, nil
+}
+
+// GetConnectorStatus returns the running state of a connector and its tasks, or nil if it doesn't exist
+func (c *AxonopsHttpClient) GetConnectorStatus(ctx context.Context, clusterName, connectClusterName, connectorName string) (*ConnectorStatus, error) {
+	entry, err := c.getConnectorEntry(ctx, clusterName, connectClusterName, connectorName)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	return &entry.Status, nil
+}
+
+func (c *AxonopsHttpClient) getConnectorEntry(ctx context.Context, clusterName, connectClusterName, connectorName string) (*ConnectorListEntry, error) {
+	// Use the connectors list endpoint and filter for the specific connector
+	// The single connector GET endpoint has known issues with AxonOps API
+	connectors, err := c.GetConnectors(ctx, clusterName, connectClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	if connector, exists := connectors[connectorName]; exists {
+		return &connector, nil
+	}
+	return nil, nil // Connector not found
+}
+
+// GetConnectors returns the connectors of a Kafka Connect cluster keyed by name, or nil if
+// the connect cluster doesn't exist
+func (c *AxonopsHttpClient) GetConnectors(ctx context.Context, clusterName, connectClusterName string) (map[string]ConnectorListEntry, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/connectors", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result ConnectorsListResponse
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return result.Connectors, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil // Connect cluster not found
+	} else {
+		return nil, fmt.Errorf("failed to get connectors: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+func (c *AxonopsHttpClient) UpdateConnectorConfig(ctx context.Context, clusterName, connectClusterName, connectorName string, config map[string]string) (*KafkaConnectorResponse, error) {
+	payload := KafkaConnectorConfig{
+		Config: config,
+	}
+
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/%s/config", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName, connectorName)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result KafkaConnectorResponse
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else {
+		return nil, fmt.Errorf("failed to update connector config: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+func (c *AxonopsHttpClient) DeleteConnector(ctx context.Context, clusterName, connectClusterName, connectorName string) error {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName, connectorName)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 204 || resp.StatusCode == 200 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to delete connector: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+func (c *AxonopsHttpClient) RestartConnector(ctx context.Context, clusterName, connectClusterName, connectorName string) error {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/%s/restart", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName, connectorName)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 202, 204}, 0, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 202 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to restart connector: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// PauseConnector stops the connector and its tasks without deleting it
+func (c *AxonopsHttpClient) PauseConnector(ctx context.Context, clusterName, connectClusterName, connectorName string) error {
+	return c.setConnectorPaused(ctx, clusterName, connectClusterName, connectorName, "pause")
+}
+
+// ResumeConnector restarts a paused connector
+func (c *AxonopsHttpClient) ResumeConnector(ctx context.Context, clusterName, connectClusterName, connectorName string) error {
+	return c.setConnectorPaused(ctx, clusterName, connectClusterName, connectorName, "resume")
+}
+
+func (c *AxonopsHttpClient) setConnectorPaused(ctx context.Context, clusterName, connectClusterName, connectorName, action string) error {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/connect/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, connectClusterName, connectorName, action)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 202, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 202 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to %s connector: status %d for url %v, body: %s", action, resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// Schema Registry types and methods
+
+type SchemaReference struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+type CreateSchemaRequest struct {
+	Schema     string            `json:"schema"`
+	SchemaType string            `json:"schemaType"`
+	References []SchemaReference `json:"references,omitempty"`
+}
+
+type CreateSchemaResponse struct {
+	Id int `json:"id"`
+}
+
+// NormalizeSchemaString returns a canonical form of a schema so that formatting
+// differences don't show up as changes. AVRO and JSON schemas are round-tripped
+// through encoding/json, which strips whitespace and sorts object keys. AVRO field
+// order is kept since it is significant for serialization. PROTOBUF schemas have
+// their whitespace collapsed. An empty schema type is treated as AVRO.
+func NormalizeSchemaString(s string, schemaType string) (string, error) {
+	switch strings.ToUpper(schemaType) {
+	case "", "AVRO", "JSON":
+		var v interface{}
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return "", fmt.Errorf("failed to parse schema: %w", err)
+		}
+		normalized, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode schema: %w", err)
+		}
+		return string(normalized), nil
+	case "PROTOBUF":
+		return strings.Join(strings.Fields(s), " "), nil
+	default:
+		return "", fmt.Errorf("unsupported schema type: %s", schemaType)
+	}
+}
+
+type SchemaRegistryVersionedSchema struct {
+	Id            int               `json:"id"`
+	Version       int               `json:"version"`
+	Schema        string            `json:"schema"`
+	Type          string            `json:"type"`
+	References    []SchemaReference `json:"references"`
+	IsSoftDeleted bool              `json:"isSoftDeleted"`
+}
+
+func (c *AxonopsHttpClient) CreateSchema(ctx context.Context, clusterName, subject string, schema CreateSchemaRequest) (*CreateSchemaResponse, error) {
+	payloadJson, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 201}, 0, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 201 {
+		var result CreateSchemaResponse
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else {
+		return nil, fmt.Errorf("failed to create schema: status %d for url %v", resp.StatusCode, url)
+	}
+}
+
+func (c *AxonopsHttpClient) GetSchema(ctx context.Context, clusterName, subject string, version string) (*SchemaRegistryVersionedSchema, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject, version)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result SchemaRegistryVersionedSchema
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil // Schema not found
+	} else {
+		return nil, fmt.Errorf("failed to get schema: status %d for url %v", resp.StatusCode, url)
+	}
+}
+
+// SchemaByID is a schema as registered under its global ID
+type SchemaByID struct {
+	Schema     string            `json:"schema"`
+	SchemaType string            `json:"schemaType"`
+	References []SchemaReference `json:"references"`
+}
+
+// GetSchemaByID returns the schema registered with a global schema ID, or nil if there is none
+func (c *AxonopsHttpClient) GetSchemaByID(ctx context.Context, clusterName string, id int) (*SchemaByID, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/schemas/ids/%d", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result SchemaByID
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode schema response: %w", err)
+		}
+		// The registry leaves out the type of AVRO schemas
+		if result.SchemaType == "" {
+			result.SchemaType = "AVRO"
+		}
+		return &result, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil // Schema not found
+	} else {
+		return nil, fmt.Errorf("failed to get schema: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// SchemaSubjectVersion is a subject version a schema ID is registered under
+type SchemaSubjectVersion struct {
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+// GetSchemaIDVersions lists the subject versions registered with a schema ID
+func (c *AxonopsHttpClient) GetSchemaIDVersions(ctx context.Context, clusterName string, id int) ([]SchemaSubjectVersion, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/schemas/ids/%d/versions", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get schema versions: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	var versions []SchemaSubjectVersion
+	if err := json.Unmarshal(bodyBytes, &versions); err != nil {
+		return nil, fmt.Errorf("failed to decode schema versions response: %w", err)
+	}
+
+	return versions, nil
+}
+
+// GetSchemaSubjects lists the subjects registered in the Schema Registry
+func (c *AxonopsHttpClient) GetSchemaSubjects(ctx context.Context, clusterName string) ([]string, error) {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get schema subjects: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	var subjects []string
+	if err := json.Unmarshal(bodyBytes, &subjects); err != nil {
+		return nil, fmt.Errorf("failed to decode schema subjects response: %w", err)
+	}
+
+	return subjects, nil
+}
+
+func (c *AxonopsHttpClient) DeleteSchema(ctx context.Context, clusterName, subject string) error {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	resp, _, err := c.doWithRetry(req, nil, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to delete schema: status %d for url %v", resp.StatusCode, url)
+	}
+}
+
+// HardDeleteSchema permanently removes a subject and all of its versions. The subject
+// must have been soft deleted with DeleteSchema first.
+func (c *AxonopsHttpClient) HardDeleteSchema(ctx context.Context, clusterName, subject string) error {
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects/%s?permanent=true", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 || resp.StatusCode == 404 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to hard delete schema: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+type SchemaCompatibilityResponse struct {
+	IsCompatible bool     `json:"is_compatible"`
+	Messages     []string `json:"messages"`
+}
+
+func (c *AxonopsHttpClient) CheckSchemaCompatibility(ctx context.Context, clusterName, subject string, schema CreateSchemaRequest) (*SchemaCompatibilityResponse, error) {
+	payloadJson, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/compatibility/subjects/%s/versions?verbose=true", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result SchemaCompatibilityResponse
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil // Subject has no versions yet, nothing to be compatible with
+	} else {
+		return nil, fmt.Errorf("failed to check schema compatibility: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// SchemaCompatibilityConfig is the compatibility setting returned by the registry config endpoints
+type SchemaCompatibilityConfig struct {
+	CompatibilityLevel string `json:"compatibilityLevel"`
+}
+
+type SetSchemaCompatibilityRequest struct {
+	Compatibility string `json:"compatibility"`
+}
+
+// schemaConfigUrl returns the global registry config endpoint, or the subject's when subject is set
+func (c *AxonopsHttpClient) schemaConfigUrl(clusterName, subject string) string {
+	if subject == "" {
+		return fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/config", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+	}
+	return fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects/%s/config", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject)
+}
+
+// GetSchemaCompatibility returns the compatibility level of a subject, or the global level when subject
+// is empty. An empty level is returned when the subject has no override.
+func (c *AxonopsHttpClient) GetSchemaCompatibility(ctx context.Context, clusterName, subject string) (string, error) {
+	url := c.schemaConfigUrl(clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return "", fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result SchemaCompatibilityConfig
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return "", fmt.Errorf("failed to decode response: %w", err)
+		}
+		return result.CompatibilityLevel, nil
+	} else if resp.StatusCode == 404 {
+		return "", nil
+	} else {
+		return "", fmt.Errorf("failed to get schema compatibility: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// SetSchemaCompatibility sets the compatibility level of a subject, or the global level when subject is empty
+func (c *AxonopsHttpClient) SetSchemaCompatibility(ctx context.Context, clusterName, subject, level string) error {
+	payloadJson, err := json.Marshal(SetSchemaCompatibilityRequest{Compatibility: level})
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := c.schemaConfigUrl(clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to set schema compatibility: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// DeleteSchemaCompatibility removes a subject's compatibility override so it falls back to the global level
+func (c *AxonopsHttpClient) DeleteSchemaCompatibility(ctx context.Context, clusterName, subject string) error {
+	url := c.schemaConfigUrl(clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 || resp.StatusCode == 404 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to delete schema compatibility: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// SchemaRegistryConfig holds the global Schema Registry settings
+type SchemaRegistryConfig struct {
+	CompatibilityLevel string
+	Mode               string
+}
+
+// SchemaRegistryMode is the body of the registry mode endpoint
+type SchemaRegistryMode struct {
+	Mode string `json:"mode"`
+}
+
+func (c *AxonopsHttpClient) schemaModeUrl(clusterName string) string {
+	return fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/mode", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+}
+
+// GetSchemaRegistryConfig returns the global compatibility level and mode of the Schema Registry
+func (c *AxonopsHttpClient) GetSchemaRegistryConfig(ctx context.Context, clusterName string) (*SchemaRegistryConfig, error) {
+	level, err := c.GetSchemaCompatibility(ctx, clusterName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	url := c.schemaModeUrl(clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get schema registry mode: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	var mode SchemaRegistryMode
+	if err := json.Unmarshal(bodyBytes, &mode); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &SchemaRegistryConfig{CompatibilityLevel: level, Mode: mode.Mode}, nil
+}
+
+// UpdateSchemaRegistryConfig sets the global compatibility level and mode, leaving empty settings unchanged
+func (c *AxonopsHttpClient) UpdateSchemaRegistryConfig(ctx context.Context, clusterName string, config SchemaRegistryConfig) error {
+	if config.CompatibilityLevel != "" {
+		if err := c.SetSchemaCompatibility(ctx, clusterName, "", config.CompatibilityLevel); err != nil {
+			return err
+		}
+	}
+
+	if config.Mode == "" {
+		return nil
+	}
+
+	payloadJson, err := json.Marshal(SchemaRegistryMode{Mode: config.Mode})
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := c.schemaModeUrl(clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to set schema registry mode: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+func (c *AxonopsHttpClient) subjectModeUrl(clusterName, subject string) string {
+	return fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/subjects/%s/mode", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, subject)
+}
+
+// GetSubjectMode returns the mode of a subject. An empty mode is returned when the subject has no override.
+func (c *AxonopsHttpClient) GetSubjectMode(ctx context.Context, clusterName, subject string) (string, error) {
+	url := c.subjectModeUrl(clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return "", fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var mode SchemaRegistryMode
+		if err := json.Unmarshal(bodyBytes, &mode); err != nil {
+			return "", fmt.Errorf("failed to decode response: %w", err)
+		}
+		return mode.Mode, nil
+	} else if resp.StatusCode == 404 {
+		return "", nil
+	} else {
+		return "", fmt.Errorf("failed to get subject mode: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// SetSubjectMode overrides the global mode for a subject
+func (c *AxonopsHttpClient) SetSubjectMode(ctx context.Context, clusterName, subject, mode string) error {
+	payloadJson, err := json.Marshal(SchemaRegistryMode{Mode: mode})
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := c.subjectModeUrl(clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to set subject mode: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// DeleteSubjectMode removes a subject's mode override so it falls back to the global mode
+func (c *AxonopsHttpClient) DeleteSubjectMode(ctx context.Context, clusterName, subject string) error {
+	url := c.subjectModeUrl(clusterName, subject)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 || resp.StatusCode == 404 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to delete subject mode: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// SchemaNamingStrategy is the body of the registry naming strategy endpoint
+type SchemaNamingStrategy struct {
+	Strategy string `json:"strategy"`
+}
+
+func (c *AxonopsHttpClient) schemaNamingStrategyUrl(clusterName string) string {
+	return fmt.Sprintf("%s://%s/%s/%s/kafka/%s/registry/namingStrategy", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName)
+}
+
+// GetSchemaNamingStrategy returns the subject naming strategy of the Schema Registry
+func (c *AxonopsHttpClient) GetSchemaNamingStrategy(ctx context.Context, clusterName string) (string, error) {
+	url := c.schemaNamingStrategyUrl(clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return "", fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("failed to get schema naming strategy: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	var strategy SchemaNamingStrategy
+	if err := json.Unmarshal(bodyBytes, &strategy); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return strategy.Strategy, nil
+}
+
+// SetSchemaNamingStrategy sets the subject naming strategy of the Schema Registry
+func (c *AxonopsHttpClient) SetSchemaNamingStrategy(ctx context.Context, clusterName, strategy string) error {
+	payloadJson, err := json.Marshal(SchemaNamingStrategy{Strategy: strategy})
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := c.schemaNamingStrategyUrl(clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to set schema naming strategy: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// Log Collector types and methods
+
+type LogCollectorConfig struct {
+	Name               string   `json:"name"`
+	UUID               string   `json:"uuid"`
+	Filename           string   `json:"filename"`
+	DateFormat         string   `json:"dateFormat"`
+	InfoRegex          string   `json:"infoRegex"`
+	WarningRegex       string   `json:"warningRegex"`
+	ErrorRegex         string   `json:"errorRegex"`
+	DebugRegex         string   `json:"debugRegex"`
+	SupportedAgentType []string `json:"supportedAgentType"`
+	ErrorAlertThreshold int     `json:"errorAlertThreshold,omitempty"`
+	Archive            *ArchiveConfig `json:"archive,omitempty"`
+}
+
+// ArchiveConfig configures archiving of collected logs to remote storage
+type ArchiveConfig struct {
+	Enabled      bool   `json:"enabled"`
+	RemoteType   string `json:"remoteType"`
+	RemotePath   string `json:"remotePath"`
+	RemoteConfig string `json:"remoteConfig,omitempty"`
+	ArchiveAfter string `json:"archiveAfter,omitempty"`
+}
+
+func (c *AxonopsHttpClient) GetLogCollectors(ctx context.Context, clusterType, clusterName string) ([]LogCollectorConfig, error) {
+	url := fmt.Sprintf("%s://%s/api/v1/logcollectors/%s/%s/%s", c.protocol, c.axonopsHost, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result []LogCollectorConfig
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return result, nil
+	} else {
+		return nil, fmt.Errorf("failed to get log collectors: status %d for url %v", resp.StatusCode, url)
+	}
+}
+
+func (c *AxonopsHttpClient) UpdateLogCollectors(ctx context.Context, clusterType, clusterName string, collectors []LogCollectorConfig) error {
+	collectorsJson, err := json.Marshal(collectors)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	reqUrl := fmt.Sprintf("%s://%s/api/v1/logcollectors/%s/%s/%s", c.protocol, c.axonopsHost, c.orgid, clusterType, clusterName)
+
+	// The API expects form-urlencoded data with addlogs parameter
+	// URL-encode the JSON to properly handle special characters
+	formData := "addlogs=" + url.QueryEscape(string(collectorsJson))
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", reqUrl, bytes.NewBufferString(formData))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, reqUrl)
+	}
+
+	// Set headers
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	resp, _, err := c.doWithRetry(req, []byte(formData), []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to update log collectors: status %d for url %v", resp.StatusCode, reqUrl)
+	}
+}
+
+// Healthcheck types and methods
+
+type HealthcheckIntegrations struct {
+	Type            string   `json:"Type"`
+	Routing         []string `json:"Routing"`
+	OverrideInfo    bool     `json:"OverrideInfo"`
+	OverrideWarning bool     `json:"OverrideWarning"`
+	OverrideError   bool     `json:"OverrideError"`
+}
+
+type ShellHealthcheck struct {
+	ID           string                  `json:"id"`
+	Name         string                  `json:"name"`
+	Interval     string                  `json:"interval"`
+	Timeout      string                  `json:"timeout"`
+	Integrations HealthcheckIntegrations `json:"integrations"`
+	Readonly     bool                    `json:"readonly"`
+	Shell        string                  `json:"shell"`
+	Script       string                  `json:"script"`
+
+	SuccessExitCodes []int             `json:"successExitCodes,omitempty"`
+	Env              map[string]string `json:"env,omitempty"`
+}
+
+type HTTPHealthcheck struct {
+	ID                 string                  `json:"id"`
+	Name               string                  `json:"name"`
+	Interval           string                  `json:"interval"`
+	Timeout            string                  `json:"timeout"`
 	Integrations       HealthcheckIntegrations `json:"integrations"`
 	Readonly           bool                    `json:"readonly"`
 	SupportedAgentType []string                `json:"supportedAgentType"`
@@ -945,104 +2785,644 @@ type HTTPHealthcheck struct {
 	Headers            map[string]string       `json:"headers,omitempty"`
 	Body               string                  `json:"body,omitempty"`
 	ExpectedStatus     int                     `json:"expectedStatus,omitempty"`
+	TLSSkipVerify      bool                    `json:"tlsSkipVerify"`
+}
+
+type TCPHealthcheck struct {
+	ID                 string                  `json:"id"`
+	Name               string                  `json:"name"`
+	Interval           string                  `json:"interval"`
+	Timeout            string                  `json:"timeout"`
+	Integrations       HealthcheckIntegrations `json:"integrations"`
+	Readonly           bool                    `json:"readonly"`
+	SupportedAgentType []string                `json:"supportedAgentType"`
+	TCP                string                  `json:"tcp"`
+}
+
+type HealthchecksResponse struct {
+	ShellChecks []ShellHealthcheck `json:"shellchecks"`
+	HTTPChecks  []HTTPHealthcheck  `json:"httpchecks"`
+	TCPChecks   []TCPHealthcheck   `json:"tcpchecks"`
+}
+
+func (c *AxonopsHttpClient) GetHealthchecks(ctx context.Context, clusterType, clusterName string) (*HealthchecksResponse, error) {
+	url := fmt.Sprintf("%s://%s/api/v1/healthchecks/%s/%s/%s", c.protocol, c.axonopsHost, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	// Set headers
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result HealthchecksResponse
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else {
+		return nil, fmt.Errorf("failed to get healthchecks: status %d for url %v", resp.StatusCode, url)
+	}
+}
+
+func (c *AxonopsHttpClient) UpdateHealthchecks(ctx context.Context, clusterType, clusterName string, healthchecks HealthchecksResponse) error {
+	payloadJson, err := json.Marshal(healthchecks)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	reqUrl := fmt.Sprintf("%s://%s/api/v1/healthchecks/%s/%s/%s", c.protocol, c.axonopsHost, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", reqUrl, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, reqUrl)
+	}
+
+	// Set headers
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	resp, _, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to update healthchecks: status %d for url %v", resp.StatusCode, reqUrl)
+	}
+}
+
+// Adaptive Repair types and methods
+
+type AdaptiveRepairSettings struct {
+	Active               bool     `json:"Active"`
+	GcGraceThreshold     int      `json:"GcGraceThreshold"`
+	TableParallelism     int      `json:"TableParallelism"`
+	BlacklistedTables    []string `json:"BlacklistedTables"`
+	FilterTWCSTables     bool     `json:"FilterTWCSTables"`
+	SegmentRetries       int      `json:"SegmentRetries"`
+	SegmentsPerVnode     int      `json:"SegmentsPerVnode,omitempty"`
+	SegmentTargetSizeMB  int      `json:"SegmentTargetSizeMB,omitempty"`
+	MaxConcurrentRepairs int      `json:"MaxConcurrentRepairs,omitempty"`
+
+	ScheduleOverrides []AdaptiveRepairScheduleOverride `json:"ScheduleOverrides,omitempty"`
+}
+
+// AdaptiveRepairScheduleOverride replaces the repair settings during an hour window
+type AdaptiveRepairScheduleOverride struct {
+	HourStart        int `json:"HourStart"`
+	HourEnd          int `json:"HourEnd"`
+	TableParallelism int `json:"TableParallelism"`
+	SegmentRetries   int `json:"SegmentRetries"`
+}
+
+func (c *AxonopsHttpClient) GetCassandraAdaptiveRepair(ctx context.Context, clusterType, clusterName string) (*AdaptiveRepairSettings, error) {
+	url := fmt.Sprintf("%s://%s/%s/adaptiveRepair/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result AdaptiveRepairSettings
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else {
+		return nil, fmt.Errorf("failed to get adaptive repair settings: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+func (c *AxonopsHttpClient) UpdateCassandraAdaptiveRepair(ctx context.Context, clusterType, clusterName string, settings AdaptiveRepairSettings) error {
+	payloadJson, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/adaptiveRepair/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to update adaptive repair settings: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// RepairStatus describes the progress of the adaptive repair currently running on a cluster
+type RepairStatus struct {
+	IsRunning               bool    `json:"IsRunning"`
+	CurrentKeyspace         string  `json:"CurrentKeyspace"`
+	CurrentTable            string  `json:"CurrentTable"`
+	PercentComplete         float64 `json:"PercentComplete"`
+	EstimatedCompletionTime string  `json:"EstimatedCompletionTime"`
+	SegmentsCompleted       int     `json:"SegmentsCompleted"`
+	SegmentsTotal           int     `json:"SegmentsTotal"`
+}
+
+func (c *AxonopsHttpClient) GetAdaptiveRepairStatus(ctx context.Context, clusterType, clusterName string) (*RepairStatus, error) {
+	url := fmt.Sprintf("%s://%s/%s/adaptiveRepairStatus/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result RepairStatus
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else {
+		return nil, fmt.Errorf("failed to get adaptive repair status: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// RepairHistoryEntry is one repair run of a cluster
+type RepairHistoryEntry struct {
+	ID              string  `json:"ID"`
+	StartTime       string  `json:"StartTime"`
+	EndTime         string  `json:"EndTime"`
+	Status          string  `json:"Status"`
+	Keyspace        string  `json:"Keyspace"`
+	Table           string  `json:"Table"`
+	Datacenter      string  `json:"Datacenter"`
+	ProgressPercent float64 `json:"ProgressPercent"`
+}
+
+// GetRepairHistory returns the most recent repair runs of a cluster, newest first.
+// Zero start or end times leave that end of the window open.
+func (c *AxonopsHttpClient) GetRepairHistory(ctx context.Context, clusterType, clusterName string, limit int, start, end time.Time) ([]RepairHistoryEntry, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	if !start.IsZero() {
+		query.Set("start", strconv.FormatInt(start.Unix(), 10))
+	}
+	if !end.IsZero() {
+		query.Set("end", strconv.FormatInt(end.Unix(), 10))
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/repairHistory/%s/%s/%s?%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result []RepairHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return result, nil
+	} else {
+		return nil, fmt.Errorf("failed to get repair history: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// RollingRestartRequest starts a rolling restart of the nodes of a cluster
+type RollingRestartRequest struct {
+	WaitForSchemaAgreement bool   `json:"waitForSchemaAgreement"`
+	InterNodeDelay         string `json:"interNodeDelay"`
+	Datacenter             string `json:"datacenter,omitempty"`
+}
+
+// RollingRestartStatus describes the progress of the rolling restart of a cluster
+type RollingRestartStatus struct {
+	InProgress     bool   `json:"inProgress"`
+	CurrentNode    string `json:"currentNode"`
+	NodesCompleted int    `json:"nodesCompleted"`
+	NodesTotal     int    `json:"nodesTotal"`
+}
+
+// InitiateRollingRestart starts restarting the nodes of a cluster one at a time
+func (c *AxonopsHttpClient) InitiateRollingRestart(ctx context.Context, clusterType, clusterName string, restart RollingRestartRequest) error {
+	payloadJson, err := json.Marshal(restart)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/rollingRestart/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 202, 204}, 0, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 202 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to start rolling restart: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// GetRollingRestartStatus returns the progress of the rolling restart of a cluster
+func (c *AxonopsHttpClient) GetRollingRestartStatus(ctx context.Context, clusterType, clusterName string) (*RollingRestartStatus, error) {
+	url := fmt.Sprintf("%s://%s/%s/rollingRestartStatus/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result RollingRestartStatus
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	} else {
+		return nil, fmt.Errorf("failed to get rolling restart status: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// NodeWorkload is the DSE workload type assigned to a node
+type NodeWorkload struct {
+	WorkloadType string `json:"workloadType"`
+}
+
+func (c *AxonopsHttpClient) nodeWorkloadUrl(clusterName, nodeID string) string {
+	// Workloads only exist on DSE clusters
+	return fmt.Sprintf("%s://%s/%s/nodeWorkload/%s/dse/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterName, nodeID)
+}
+
+// GetNodeWorkload returns the workload type of a DSE node
+func (c *AxonopsHttpClient) GetNodeWorkload(ctx context.Context, clusterName, nodeID string) (string, error) {
+	url := c.nodeWorkloadUrl(clusterName, nodeID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return "", fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode == 200 {
+		var result NodeWorkload
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return "", fmt.Errorf("failed to decode response: %w", err)
+		}
+		return result.WorkloadType, nil
+	} else {
+		return "", fmt.Errorf("failed to get node workload: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// SetNodeWorkload assigns a workload type to a DSE node
+func (c *AxonopsHttpClient) SetNodeWorkload(ctx context.Context, clusterName, nodeID, workloadType string) error {
+	payloadJson, err := json.Marshal(NodeWorkload{WorkloadType: workloadType})
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := c.nodeWorkloadUrl(clusterName, nodeID)
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to set node workload: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// Cassandra Backup types and methods
+
+type CassandraBackup struct {
+	ID                      string   `json:"ID"`
+	Tag                     string   `json:"tag"`
+	LocalRetentionDuration  string   `json:"LocalRetentionDuration"`
+	Remote                  bool     `json:"Remote"`
+	RemoteConfig            string   `json:"remoteConfig,omitempty"`
+	RemotePath              string   `json:"remotePath,omitempty"`
+	RemoteRetentionDuration string   `json:"RemoteRetentionDuration,omitempty"`
+	RemoteType              string   `json:"remoteType,omitempty"`
+	Timeout                 string   `json:"timeout,omitempty"`
+	Transfers               int      `json:"transfers,omitempty"`
+	TpsLimit                int      `json:"tpslimit,omitempty"`
+	BwLimit                 string   `json:"bwlimit,omitempty"`
+	Datacenters             []string `json:"datacenters"`
+	Nodes                   []string `json:"nodes"`
+	Tables                  []string `json:"tables"`
+	Keyspaces               []string `json:"keyspaces"`
+	AllTables               bool     `json:"allTables"`
+	AllNodes                bool     `json:"allNodes"`
+	Schedule                bool     `json:"schedule"`
+	ScheduleExpr            string   `json:"scheduleExpr"`
+}
+
+type CassandraBackupsResponse struct {
+	ScheduledSnapshots []CassandraScheduledSnapshot `json:"ScheduledSnapshots"`
+}
+
+type CassandraScheduledSnapshot struct {
+	ID     string          `json:"ID"`
+	Params json.RawMessage `json:"Params"`
+}
+
+type CassandraScheduledParam struct {
+	BackupDetails string `json:"BackupDetails"`
+}
+
+func (c *AxonopsHttpClient) GetCassandraBackups(ctx context.Context, clusterType, clusterName string) ([]CassandraBackup, error) {
+	url := fmt.Sprintf("%s://%s/%s/cassandraScheduleSnapshot/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send GET request: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get cassandra backups: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+
+	var response CassandraBackupsResponse
+	if err := json.Unmarshal(bodyBytes, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var backups []CassandraBackup
+	for _, snapshot := range response.ScheduledSnapshots {
+		if len(snapshot.Params) == 0 {
+			continue
+		}
+
+		// Params can be a JSON string or an array of objects
+		var params []CassandraScheduledParam
+		if err := json.Unmarshal(snapshot.Params, &params); err != nil {
+			// Try as a JSON string containing the array
+			var paramsStr string
+			if err2 := json.Unmarshal(snapshot.Params, &paramsStr); err2 == nil {
+				json.Unmarshal([]byte(paramsStr), &params)
+			}
+		}
+
+		for _, param := range params {
+			if param.BackupDetails != "" {
+				var backup CassandraBackup
+				if err := json.Unmarshal([]byte(param.BackupDetails), &backup); err != nil {
+					continue
+				}
+				if backup.ID == "" {
+					backup.ID = snapshot.ID
+				}
+				backups = append(backups, backup)
+			}
+		}
+	}
+
+	return backups, nil
+}
+
+func (c *AxonopsHttpClient) CreateCassandraBackup(ctx context.Context, clusterType, clusterName string, backup CassandraBackup) error {
+	payloadJson, err := json.Marshal(backup)
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/cassandraSnapshot/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 201, 204}, 0, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 201 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to create cassandra backup: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
 }
 
-type TCPHealthcheck struct {
-	ID                 string                  `json:"id"`
-	Name               string                  `json:"name"`
-	Interval           string                  `json:"interval"`
-	Timeout            string                  `json:"timeout"`
-	Integrations       HealthcheckIntegrations `json:"integrations"`
-	Readonly           bool                    `json:"readonly"`
-	SupportedAgentType []string                `json:"supportedAgentType"`
-	TCP                string                  `json:"tcp"`
+// CassandraBackupStatus describes the progress of a single snapshot run
+type CassandraBackupStatus struct {
+	ID     string `json:"ID"`
+	Status string `json:"Status"`
+	Error  string `json:"Error,omitempty"`
 }
 
-type HealthchecksResponse struct {
-	ShellChecks []ShellHealthcheck `json:"shellchecks"`
-	HTTPChecks  []HTTPHealthcheck  `json:"httpchecks"`
-	TCPChecks   []TCPHealthcheck   `json:"tcpchecks"`
+// TriggerImmediateBackup takes a one-off snapshot using the given backup parameters.
+// The backup is sent with scheduling disabled so it runs once, straight away.
+func (c *AxonopsHttpClient) TriggerImmediateBackup(ctx context.Context, clusterType, clusterName string, backup CassandraBackup) error {
+	backup.Schedule = false
+	backup.ScheduleExpr = ""
+
+	return c.CreateCassandraBackup(ctx, clusterType, clusterName, backup)
 }
 
-func (c *AxonopsHttpClient) GetHealthchecks(clusterName string) (*HealthchecksResponse, error) {
-	url := fmt.Sprintf("%s://%s/api/v1/healthchecks/%s/kafka/%s", c.protocol, c.axonopsHost, c.orgid, clusterName)
+// GetCassandraBackupStatus returns the status of a snapshot run, or nil if it is not known yet
+func (c *AxonopsHttpClient) GetCassandraBackupStatus(ctx context.Context, clusterType, clusterName, backupID string) (*CassandraBackupStatus, error) {
+	url := fmt.Sprintf("%s://%s/%s/cassandraSnapshotStatus/%s/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName, backupID)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
 
-	// Set headers
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode == 200 {
-		var result HealthchecksResponse
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		var result CassandraBackupStatus
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
 			return nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 		return &result, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil // Snapshot not started yet
 	} else {
-		return nil, fmt.Errorf("failed to get healthchecks: status %d for url %v", resp.StatusCode, url)
+		return nil, fmt.Errorf("failed to get cassandra backup status: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-func (c *AxonopsHttpClient) UpdateHealthchecks(clusterName string, healthchecks HealthchecksResponse) error {
-	payloadJson, err := json.Marshal(healthchecks)
+// BackupVerificationSchedule describes periodic restore verification of a backup
+type BackupVerificationSchedule struct {
+	BackupID     string `json:"BackupID"`
+	Datacenter   string `json:"Datacenter"`
+	ScheduleExpr string `json:"ScheduleExpr"`
+	Active       bool   `json:"Active"`
+}
+
+func (c *AxonopsHttpClient) SetBackupVerificationSchedule(ctx context.Context, clusterType, clusterName string, schedule BackupVerificationSchedule) error {
+	payloadJson, err := json.Marshal(schedule)
 	if err != nil {
 		return fmt.Errorf("failed to encode JSON payload: %w", err)
 	}
 
-	reqUrl := fmt.Sprintf("%s://%s/api/v1/healthchecks/%s/kafka/%s", c.protocol, c.axonopsHost, c.orgid, clusterName)
+	url := fmt.Sprintf("%s://%s/%s/cassandraBackupVerify/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
 
-	req, err := http.NewRequest("PUT", reqUrl, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
-		return fmt.Errorf("failed to create PUT request: %w for url %v", err, reqUrl)
+		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
 	}
 
-	// Set headers
 	req.Header.Set("Content-Type", "application/json")
 	if c.apiKey != "" {
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	resp, err := c.client.Do(req)
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 201, 204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return fmt.Errorf("failed to send PUT request: %w", err)
+		return fmt.Errorf("failed to send POST request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+	if resp.StatusCode == 200 || resp.StatusCode == 201 || resp.StatusCode == 204 {
 		return nil
 	} else {
-		return fmt.Errorf("failed to update healthchecks: status %d for url %v", resp.StatusCode, reqUrl)
+		return fmt.Errorf("failed to set backup verification schedule: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-// Adaptive Repair types and methods
-
-type AdaptiveRepairSettings struct {
-	Active              bool     `json:"Active"`
-	GcGraceThreshold    int      `json:"GcGraceThreshold"`
-	TableParallelism    int      `json:"TableParallelism"`
-	BlacklistedTables   []string `json:"BlacklistedTables"`
-	FilterTWCSTables    bool     `json:"FilterTWCSTables"`
-	SegmentRetries      int      `json:"SegmentRetries"`
-	SegmentsPerVnode    int      `json:"SegmentsPerVnode,omitempty"`
-	SegmentTargetSizeMB int      `json:"SegmentTargetSizeMB,omitempty"`
-}
-
-func (c *AxonopsHttpClient) GetCassandraAdaptiveRepair(clusterType, clusterName string) (*AdaptiveRepairSettings, error) {
-	url := fmt.Sprintf("%s://%s/%s/adaptiveRepair/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+// GetBackupVerificationSchedule returns the verification schedule for a backup, or nil if none is configured
+func (c *AxonopsHttpClient) GetBackupVerificationSchedule(ctx context.Context, clusterType, clusterName, backupID string) (*BackupVerificationSchedule, error) {
+	url := fmt.Sprintf("%s://%s/%s/cassandraBackupVerify/%s/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName, backupID)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
@@ -1051,39 +3431,37 @@ func (c *AxonopsHttpClient) GetCassandraAdaptiveRepair(clusterType, clusterName
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
 
 	if resp.StatusCode == 200 {
-		var result AdaptiveRepairSettings
+		var result BackupVerificationSchedule
 		if err := json.Unmarshal(bodyBytes, &result); err != nil {
 			return nil, fmt.Errorf("failed to decode response: %w", err)
 		}
 		return &result, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil // No verification schedule
 	} else {
-		return nil, fmt.Errorf("failed to get adaptive repair settings: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+		return nil, fmt.Errorf("failed to get backup verification schedule: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-func (c *AxonopsHttpClient) UpdateCassandraAdaptiveRepair(clusterType, clusterName string, settings AdaptiveRepairSettings) error {
-	payloadJson, err := json.Marshal(settings)
+func (c *AxonopsHttpClient) DeleteCassandraBackup(ctx context.Context, clusterType, clusterName string, backupIDs []string) error {
+	payloadJson, err := json.Marshal(backupIDs)
 	if err != nil {
 		return fmt.Errorf("failed to encode JSON payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s://%s/%s/adaptiveRepair/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+	url := fmt.Sprintf("%s://%s/%s/cassandraScheduleSnapshot/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
-		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1091,66 +3469,42 @@ func (c *AxonopsHttpClient) UpdateCassandraAdaptiveRepair(clusterType, clusterNa
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, payloadJson)
+	c.logRequest(req, payloadJson)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return fmt.Errorf("failed to send POST request: %w", err)
+		return fmt.Errorf("failed to send DELETE request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
-
-	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+	if resp.StatusCode == 204 || resp.StatusCode == 200 {
 		return nil
 	} else {
-		return fmt.Errorf("failed to update adaptive repair settings: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+		return fmt.Errorf("failed to delete cassandra backup: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-// Cassandra Backup types and methods
-
-type CassandraBackup struct {
-	ID                      string   `json:"ID"`
-	Tag                     string   `json:"tag"`
-	LocalRetentionDuration  string   `json:"LocalRetentionDuration"`
-	Remote                  bool     `json:"Remote"`
-	RemoteConfig            string   `json:"remoteConfig,omitempty"`
-	RemotePath              string   `json:"remotePath,omitempty"`
-	RemoteRetentionDuration string   `json:"RemoteRetentionDuration,omitempty"`
-	RemoteType              string   `json:"remoteType,omitempty"`
-	Timeout                 string   `json:"timeout,omitempty"`
-	Transfers               int      `json:"transfers,omitempty"`
-	TpsLimit                int      `json:"tpslimit,omitempty"`
-	BwLimit                 string   `json:"bwlimit,omitempty"`
-	Datacenters             []string `json:"datacenters"`
-	Nodes                   []string `json:"nodes"`
-	Tables                  []string `json:"tables"`
-	Keyspaces               []string `json:"keyspaces"`
-	AllTables               bool     `json:"allTables"`
-	AllNodes                bool     `json:"allNodes"`
-	Schedule                bool     `json:"schedule"`
-	ScheduleExpr            string   `json:"scheduleExpr"`
-}
-
-type CassandraBackupsResponse struct {
-	ScheduledSnapshots []CassandraScheduledSnapshot `json:"ScheduledSnapshots"`
-}
+// Cassandra Keyspace types and methods
 
-type CassandraScheduledSnapshot struct {
-	ID     string          `json:"ID"`
-	Params json.RawMessage `json:"Params"`
+type CassandraKeyspace struct {
+	Name                string            `json:"Name"`
+	ReplicationStrategy string            `json:"ReplicationStrategy"`
+	ReplicationOptions  map[string]string `json:"ReplicationOptions"`
 }
 
-type CassandraScheduledParam struct {
-	BackupDetails string `json:"BackupDetails"`
+// cassandraKeyspaceUrl returns the keyspaces endpoint, or the keyspace's when keyspace is set
+func (c *AxonopsHttpClient) cassandraKeyspaceUrl(clusterType, clusterName, keyspace string) string {
+	url := fmt.Sprintf("%s://%s/%s/cassandraKeyspace/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+	if keyspace != "" {
+		url += "/" + keyspace
+	}
+	return url
 }
 
-func (c *AxonopsHttpClient) GetCassandraBackups(clusterType, clusterName string) ([]CassandraBackup, error) {
-	url := fmt.Sprintf("%s://%s/%s/cassandraScheduleSnapshot/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+// GetKeyspace returns a keyspace's replication settings, or nil if the keyspace doesn't exist
+func (c *AxonopsHttpClient) GetKeyspace(ctx context.Context, clusterType, clusterName, keyspace string) (*CassandraKeyspace, error) {
+	url := c.cassandraKeyspaceUrl(clusterType, clusterName, keyspace)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
@@ -1159,68 +3513,35 @@ func (c *AxonopsHttpClient) GetCassandraBackups(clusterType, clusterName string)
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 404}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get cassandra backups: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
-	}
-
-	var response CassandraBackupsResponse
-	if err := json.Unmarshal(bodyBytes, &response); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	var backups []CassandraBackup
-	for _, snapshot := range response.ScheduledSnapshots {
-		if len(snapshot.Params) == 0 {
-			continue
-		}
-
-		// Params can be a JSON string or an array of objects
-		var params []CassandraScheduledParam
-		if err := json.Unmarshal(snapshot.Params, &params); err != nil {
-			// Try as a JSON string containing the array
-			var paramsStr string
-			if err2 := json.Unmarshal(snapshot.Params, &paramsStr); err2 == nil {
-				json.Unmarshal([]byte(paramsStr), &params)
-			}
-		}
-
-		for _, param := range params {
-			if param.BackupDetails != "" {
-				var backup CassandraBackup
-				if err := json.Unmarshal([]byte(param.BackupDetails), &backup); err != nil {
-					continue
-				}
-				if backup.ID == "" {
-					backup.ID = snapshot.ID
-				}
-				backups = append(backups, backup)
-			}
+	if resp.StatusCode == 200 {
+		var result CassandraKeyspace
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
 		}
+		return &result, nil
+	} else if resp.StatusCode == 404 {
+		return nil, nil
+	} else {
+		return nil, fmt.Errorf("failed to get keyspace: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
-
-	return backups, nil
 }
 
-func (c *AxonopsHttpClient) CreateCassandraBackup(clusterType, clusterName string, backup CassandraBackup) error {
-	payloadJson, err := json.Marshal(backup)
+func (c *AxonopsHttpClient) CreateKeyspace(ctx context.Context, clusterType, clusterName string, keyspace CassandraKeyspace) error {
+	payloadJson, err := json.Marshal(keyspace)
 	if err != nil {
 		return fmt.Errorf("failed to encode JSON payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s://%s/%s/cassandraSnapshot/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+	url := c.cassandraKeyspaceUrl(clusterType, clusterName, "")
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
 		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
 	}
@@ -1230,35 +3551,32 @@ func (c *AxonopsHttpClient) CreateCassandraBackup(clusterType, clusterName strin
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, payloadJson)
+	c.logRequest(req, payloadJson)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 201, 204}, 0, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send POST request: %w", err)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
 
 	if resp.StatusCode == 200 || resp.StatusCode == 201 || resp.StatusCode == 204 {
 		return nil
 	} else {
-		return fmt.Errorf("failed to create cassandra backup: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+		return fmt.Errorf("failed to create keyspace: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
-func (c *AxonopsHttpClient) DeleteCassandraBackup(clusterType, clusterName string, backupIDs []string) error {
-	payloadJson, err := json.Marshal(backupIDs)
+// UpdateKeyspace changes the replication settings of an existing keyspace
+func (c *AxonopsHttpClient) UpdateKeyspace(ctx context.Context, clusterType, clusterName string, keyspace CassandraKeyspace) error {
+	payloadJson, err := json.Marshal(keyspace)
 	if err != nil {
 		return fmt.Errorf("failed to encode JSON payload: %w", err)
 	}
 
-	url := fmt.Sprintf("%s://%s/%s/cassandraScheduleSnapshot/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+	url := c.cassandraKeyspaceUrl(clusterType, clusterName, keyspace.Name)
 
-	req, err := http.NewRequest("DELETE", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
-		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1266,21 +3584,43 @@ func (c *AxonopsHttpClient) DeleteCassandraBackup(clusterType, clusterName strin
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, payloadJson)
+	c.logRequest(req, payloadJson)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
-		return fmt.Errorf("failed to send DELETE request: %w", err)
+		return fmt.Errorf("failed to send PUT request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to update keyspace: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
-	defer resp.Body.Close()
+}
 
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
+func (c *AxonopsHttpClient) DeleteKeyspace(ctx context.Context, clusterType, clusterName, keyspace string) error {
+	url := c.cassandraKeyspaceUrl(clusterType, clusterName, keyspace)
 
-	if resp.StatusCode == 204 || resp.StatusCode == 200 {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 || resp.StatusCode == 404 {
 		return nil
 	} else {
-		return fmt.Errorf("failed to delete cassandra backup: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+		return fmt.Errorf("failed to delete keyspace: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
 	}
 }
 
@@ -1298,6 +3638,17 @@ type MetricAlertRule struct {
 	CorrelationId string                 `json:"correlationId,omitempty"`
 	Annotations   MetricAlertAnnotations `json:"annotations"`
 	Filters       []MetricAlertFilter    `json:"filters,omitempty"`
+	Enabled       *bool                  `json:"enabled,omitempty"`
+}
+
+// IsEnabled reports whether the rule is active. Rules without the enabled
+// field predate the toggle and are always active.
+func (r MetricAlertRule) IsEnabled() bool {
+	return r.Enabled == nil || *r.Enabled
+}
+
+type SetAlertRuleEnabledRequest struct {
+	Enabled bool `json:"enabled"`
 }
 
 type MetricAlertAnnotations struct {
@@ -1315,10 +3666,10 @@ type AlertRulesResponse struct {
 	MetricRules []MetricAlertRule `json:"metricrules"`
 }
 
-func (c *AxonopsHttpClient) GetAlertRules(clusterType, clusterName string) ([]MetricAlertRule, error) {
+func (c *AxonopsHttpClient) GetAlertRules(ctx context.Context, clusterType, clusterName string) ([]MetricAlertRule, error) {
 	url := fmt.Sprintf("%s://%s/%s/alert-rules/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
@@ -1327,16 +3678,12 @@ func (c *AxonopsHttpClient) GetAlertRules(clusterType, clusterName string) ([]Me
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
 
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("failed to get alert rules: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
@@ -1350,7 +3697,7 @@ func (c *AxonopsHttpClient) GetAlertRules(clusterType, clusterName string) ([]Me
 	return response.MetricRules, nil
 }
 
-func (c *AxonopsHttpClient) CreateOrUpdateAlertRule(clusterType, clusterName string, rule MetricAlertRule) error {
+func (c *AxonopsHttpClient) CreateOrUpdateAlertRule(ctx context.Context, clusterType, clusterName string, rule MetricAlertRule) error {
 	payloadJson, err := json.Marshal(rule)
 	if err != nil {
 		return fmt.Errorf("failed to encode JSON payload: %w", err)
@@ -1358,7 +3705,7 @@ func (c *AxonopsHttpClient) CreateOrUpdateAlertRule(clusterType, clusterName str
 
 	url := fmt.Sprintf("%s://%s/%s/alert-rules/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
 		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
 	}
@@ -1368,16 +3715,12 @@ func (c *AxonopsHttpClient) CreateOrUpdateAlertRule(clusterType, clusterName str
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, payloadJson)
+	c.logRequest(req, payloadJson)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 201}, 0, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send POST request: %w", err)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
 
 	if resp.StatusCode == 200 || resp.StatusCode == 201 {
 		return nil
@@ -1386,10 +3729,10 @@ func (c *AxonopsHttpClient) CreateOrUpdateAlertRule(clusterType, clusterName str
 	}
 }
 
-func (c *AxonopsHttpClient) DeleteAlertRule(clusterType, clusterName, alertID string) error {
+func (c *AxonopsHttpClient) DeleteAlertRule(ctx context.Context, clusterType, clusterName, alertID string) error {
 	url := fmt.Sprintf("%s://%s/%s/alert-rules/%s/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName, alertID)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
 	}
@@ -1398,16 +3741,12 @@ func (c *AxonopsHttpClient) DeleteAlertRule(clusterType, clusterName, alertID st
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send DELETE request: %w", err)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
 
 	if resp.StatusCode == 204 || resp.StatusCode == 200 {
 		return nil
@@ -1416,6 +3755,39 @@ func (c *AxonopsHttpClient) DeleteAlertRule(clusterType, clusterName, alertID st
 	}
 }
 
+// SetAlertRuleEnabled enables or disables an alert rule without changing its definition
+func (c *AxonopsHttpClient) SetAlertRuleEnabled(ctx context.Context, clusterType, clusterName, alertID string, enabled bool) error {
+	payloadJson, err := json.Marshal(SetAlertRuleEnabledRequest{Enabled: enabled})
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/alert-rules/%s/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName, alertID)
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return fmt.Errorf("failed to create PATCH request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, payloadJson)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send PATCH request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to set alert rule enabled state: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
 // Alert Route (Integration Routing) types and methods
 
 type IntegrationsResponse struct {
@@ -1446,10 +3818,10 @@ type OverridePayload struct {
 	Value bool `json:"value"`
 }
 
-func (c *AxonopsHttpClient) GetIntegrations(clusterType, clusterName string) (*IntegrationsResponse, error) {
+func (c *AxonopsHttpClient) GetIntegrations(ctx context.Context, clusterType, clusterName string) (*IntegrationsResponse, error) {
 	url := fmt.Sprintf("%s://%s/%s/integrations/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create GET request: %w for url %v", err, url)
 	}
@@ -1458,16 +3830,12 @@ func (c *AxonopsHttpClient) GetIntegrations(clusterType, clusterName string) (*I
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send GET request: %w", err)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
 
 	if resp.StatusCode == 200 {
 		var result IntegrationsResponse
@@ -1480,7 +3848,93 @@ func (c *AxonopsHttpClient) GetIntegrations(clusterType, clusterName string) (*I
 	}
 }
 
-func (c *AxonopsHttpClient) SetIntegrationOverride(clusterType, clusterName, routeType, severity string, value bool) error {
+// GetIntegrationDefinition returns the integration definition with the given ID, or nil if
+// there is none. The integrations API has no call for a single definition, so it is taken
+// from the cluster's integrations.
+func (c *AxonopsHttpClient) GetIntegrationDefinition(ctx context.Context, clusterType, clusterName, integrationID string) (*IntegrationDefinition, error) {
+	integrations, err := c.GetIntegrations(ctx, clusterType, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range integrations.Definitions {
+		if integrations.Definitions[i].ID == integrationID {
+			return &integrations.Definitions[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateIntegrationDefinition adds an integration definition to a cluster and returns its ID.
+// The API does not always return the new ID, so it is empty when the response doesn't include one.
+//
+// There is no UpdateIntegrationDefinition: the integrations API only creates, lists and
+// deletes definitions, so a changed integration has to be replaced.
+func (c *AxonopsHttpClient) CreateIntegrationDefinition(ctx context.Context, clusterType, clusterName string, definition IntegrationDefinition) (string, error) {
+	payloadJson, err := json.Marshal(definition)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JSON payload: %w", err)
+	}
+
+	url := fmt.Sprintf("%s://%s/%s/integrations/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadJson))
+	if err != nil {
+		return "", fmt.Errorf("failed to create POST request: %w for url %v", err, url)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	// Params may hold secrets, so only the type is logged
+	c.logDebug(ctx, "Creating %s integration for %s/%s", definition.Type, clusterType, clusterName)
+
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 201, 204}, 0, c.retryBackoff)
+	if err != nil {
+		return "", fmt.Errorf("failed to send POST request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 201 || resp.StatusCode == 204 {
+		var created IntegrationDefinition
+		if len(bodyBytes) > 0 && json.Unmarshal(bodyBytes, &created) == nil {
+			return created.ID, nil
+		}
+		return "", nil
+	} else {
+		return "", fmt.Errorf("failed to create integration: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+// DeleteIntegrationDefinition removes an integration definition from a cluster
+func (c *AxonopsHttpClient) DeleteIntegrationDefinition(ctx context.Context, clusterType, clusterName, integrationID string) error {
+	url := fmt.Sprintf("%s://%s/%s/integrations/%s/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName, integrationID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
+	}
+
+	c.logRequest(req, nil)
+
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204, 404}, c.maxRetries, c.retryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to send DELETE request: %w", err)
+	}
+
+	if resp.StatusCode == 200 || resp.StatusCode == 204 || resp.StatusCode == 404 {
+		return nil
+	} else {
+		return fmt.Errorf("failed to delete integration: status %d for url %v, body: %s", resp.StatusCode, url, string(bodyBytes))
+	}
+}
+
+func (c *AxonopsHttpClient) SetIntegrationOverride(ctx context.Context, clusterType, clusterName, routeType, severity string, value bool) error {
 	payload := OverridePayload{Value: value}
 	payloadJson, err := json.Marshal(payload)
 	if err != nil {
@@ -1489,7 +3943,7 @@ func (c *AxonopsHttpClient) SetIntegrationOverride(clusterType, clusterName, rou
 
 	url := fmt.Sprintf("%s://%s/%s/integrations-override/%s/%s/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName, routeType, severity)
 
-	req, err := http.NewRequest("PUT", url, bytes.NewBuffer(payloadJson))
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, bytes.NewBuffer(payloadJson))
 	if err != nil {
 		return fmt.Errorf("failed to create PUT request: %w for url %v", err, url)
 	}
@@ -1499,16 +3953,12 @@ func (c *AxonopsHttpClient) SetIntegrationOverride(clusterType, clusterName, rou
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, payloadJson)
+	c.logRequest(req, payloadJson)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, payloadJson, []int{200, 204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send PUT request: %w", err)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
 
 	if resp.StatusCode == 204 || resp.StatusCode == 200 {
 		return nil
@@ -1517,10 +3967,10 @@ func (c *AxonopsHttpClient) SetIntegrationOverride(clusterType, clusterName, rou
 	}
 }
 
-func (c *AxonopsHttpClient) AddIntegrationRoute(clusterType, clusterName, routeType, severity, integrationID string) error {
+func (c *AxonopsHttpClient) AddIntegrationRoute(ctx context.Context, clusterType, clusterName, routeType, severity, integrationID string) error {
 	url := fmt.Sprintf("%s://%s/%s/integrations-routing/%s/%s/%s/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName, routeType, severity, integrationID)
 
-	req, err := http.NewRequest("POST", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create POST request: %w for url %v", err, url)
 	}
@@ -1529,16 +3979,12 @@ func (c *AxonopsHttpClient) AddIntegrationRoute(clusterType, clusterName, routeT
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 201, 204}, 0, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send POST request: %w", err)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
 
 	if resp.StatusCode == 200 || resp.StatusCode == 201 || resp.StatusCode == 204 {
 		return nil
@@ -1547,10 +3993,10 @@ func (c *AxonopsHttpClient) AddIntegrationRoute(clusterType, clusterName, routeT
 	}
 }
 
-func (c *AxonopsHttpClient) RemoveIntegrationRoute(clusterType, clusterName, routeType, severity, integrationID string) error {
+func (c *AxonopsHttpClient) RemoveIntegrationRoute(ctx context.Context, clusterType, clusterName, routeType, severity, integrationID string) error {
 	url := fmt.Sprintf("%s://%s/%s/integrations-routing/%s/%s/%s/%s/%s/%s", c.protocol, c.axonopsHost, axonops_api_version, c.orgid, clusterType, clusterName, routeType, severity, integrationID)
 
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create DELETE request: %w for url %v", err, url)
 	}
@@ -1559,16 +4005,12 @@ func (c *AxonopsHttpClient) RemoveIntegrationRoute(clusterType, clusterName, rou
 		req.Header.Set("Authorization", c.tokenType+" "+c.apiKey)
 	}
 
-	debugRequest(req, nil)
+	c.logRequest(req, nil)
 
-	resp, err := c.client.Do(req)
+	resp, bodyBytes, err := c.doWithRetry(req, nil, []int{200, 204}, c.maxRetries, c.retryBackoff)
 	if err != nil {
 		return fmt.Errorf("failed to send DELETE request: %w", err)
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := io.ReadAll(resp.Body)
-	debugResponse(resp, bodyBytes)
 
 	if resp.StatusCode == 204 || resp.StatusCode == 200 {
 		return nil
@@ -0,0 +1,89 @@
+package axonopsClient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// captureStderr returns what f printed to stderr
+func captureStderr(t *testing.T, f func()) string {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("unable to create pipe: %s", err)
+	}
+	stderr := os.Stderr
+	os.Stderr = w
+	defer func() { os.Stderr = stderr }()
+
+	f()
+	w.Close()
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unable to read stderr: %s", err)
+	}
+	return string(out)
+}
+
+func newDebugTestClient(t *testing.T) *AxonopsHttpClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("invalid test server URL: %s", err)
+	}
+
+	client, err := CreateHTTPClient("http", serverUrl.Host, "test-key", "test-org", "Bearer", 5*time.Second,
+		ProxyConfig{}, TLSConfig{}, ConnectionConfig{MaxConnections: 2, IdleConnTimeout: time.Second})
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+	return client
+}
+
+func TestDebugOutput(t *testing.T) {
+	tests := []struct {
+		name       string
+		provider   bool // configured by the provider with SetDebug
+		debug      bool
+		wantStderr bool
+	}{
+		{"AXONOPS_DEBUG outside of Terraform", false, false, true},
+		{"debug disabled by the provider", true, false, false},
+		{"debug enabled by the provider logs with tflog", true, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("AXONOPS_DEBUG", "1")
+			client := newDebugTestClient(t)
+			if tt.provider {
+				client.SetDebug(tt.debug)
+			}
+
+			out := captureStderr(t, func() {
+				if _, err := client.GetIntegrations(context.Background(), "cassandra", "prod"); err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			})
+
+			if got := strings.Contains(out, "[AXONOPS DEBUG]"); got != tt.wantStderr {
+				t.Errorf("printed to stderr: %v, want %v; output: %q", got, tt.wantStderr, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*alertRouteDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*alertRouteDataSource)(nil)
+
+type alertRouteDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewAlertRouteDataSource() datasource.DataSource {
+	return &alertRouteDataSource{}
+}
+
+func (d *alertRouteDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *alertRouteDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_route"
+}
+
+func (d *alertRouteDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the integrations alerts of a route type and severity are routed to.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The route type: global, metrics, backups, servicechecks, nodes, commands, repairs, rollingrestart.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertRouteTypes()...),
+				},
+			},
+			"severity": schema.StringAttribute{
+				Required:    true,
+				Description: "The severity level: info, warning, error.",
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("info", "warning", "error"),
+				},
+			},
+			"filter_integration_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return routes to the integration with this name.",
+			},
+			"routes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The routes for the type and severity.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"integration_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the integration.",
+						},
+						"integration_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of integration (e.g., slack, pagerduty).",
+						},
+						"integration_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the integration.",
+						},
+						"override_info": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the route type overrides the global routes for info alerts.",
+						},
+						"override_warning": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the route type overrides the global routes for warning alerts.",
+						},
+						"override_error": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the route type overrides the global routes for error alerts.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type alertRouteDataSourceData struct {
+	ClusterName           types.String      `tfsdk:"cluster_name"`
+	ClusterType           types.String      `tfsdk:"cluster_type"`
+	RouteType             types.String      `tfsdk:"type"`
+	Severity              types.String      `tfsdk:"severity"`
+	FilterIntegrationName types.String      `tfsdk:"filter_integration_name"`
+	Routes                []alertRouteEntry `tfsdk:"routes"`
+}
+
+type alertRouteEntry struct {
+	IntegrationID   types.String `tfsdk:"integration_id"`
+	IntegrationType types.String `tfsdk:"integration_type"`
+	IntegrationName types.String `tfsdk:"integration_name"`
+	OverrideInfo    types.Bool   `tfsdk:"override_info"`
+	OverrideWarning types.Bool   `tfsdk:"override_warning"`
+	OverrideError   types.Bool   `tfsdk:"override_error"`
+}
+
+func (d *alertRouteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data alertRouteDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRouteType, err := getAPIRouteType(data.RouteType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Route Type", err.Error())
+		return
+	}
+
+	integrations, err := d.client.GetIntegrations(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
+		return
+	}
+
+	definitions := make(map[string]axonopsClient.IntegrationDefinition)
+	for _, def := range integrations.Definitions {
+		definitions[def.ID] = def
+	}
+
+	routes := []alertRouteEntry{}
+	// Decode the API route type for comparison (URL-decode %20 to space)
+	decodedAPIRouteType := strings.ReplaceAll(apiRouteType, "%20", " ")
+	for _, routing := range integrations.Routings {
+		if routing.Type != decodedAPIRouteType {
+			continue
+		}
+		for _, route := range routing.Routing {
+			if !strings.EqualFold(route.Severity, data.Severity.ValueString()) {
+				continue
+			}
+			def := definitions[route.ID]
+			if !data.FilterIntegrationName.IsNull() && !strings.EqualFold(def.Params["name"], data.FilterIntegrationName.ValueString()) {
+				continue
+			}
+			routes = append(routes, alertRouteEntry{
+				IntegrationID:   types.StringValue(route.ID),
+				IntegrationType: types.StringValue(def.Type),
+				IntegrationName: types.StringValue(def.Params["name"]),
+				OverrideInfo:    types.BoolValue(routing.OverrideInfo),
+				OverrideWarning: types.BoolValue(routing.OverrideWarning),
+				OverrideError:   types.BoolValue(routing.OverrideError),
+			})
+		}
+		break
+	}
+	data.Routes = routes
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*alertRoutesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*alertRoutesDataSource)(nil)
+
+type alertRoutesDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewAlertRoutesDataSource() datasource.DataSource {
+	return &alertRoutesDataSource{}
+}
+
+func (d *alertRoutesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *alertRoutesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_routes"
+}
+
+func (d *alertRoutesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all alert routes of a cluster, for every route type and severity.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+			},
+			"routes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The routes of the cluster, one entry per route type.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The route type: global, metrics, backups, servicechecks, nodes, commands, repairs, rollingrestart.",
+						},
+						"override_info": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the route type overrides the global routes for info alerts.",
+						},
+						"override_warning": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the route type overrides the global routes for warning alerts.",
+						},
+						"override_error": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the route type overrides the global routes for error alerts.",
+						},
+						"routing": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The integrations alerts of the route type are sent to.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"integration_id": schema.StringAttribute{
+										Computed:    true,
+										Description: "The ID of the integration.",
+									},
+									"severity": schema.StringAttribute{
+										Computed:    true,
+										Description: "The severity level: info, warning, error.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type alertRoutesDataSourceData struct {
+	ClusterName types.String        `tfsdk:"cluster_name"`
+	ClusterType types.String        `tfsdk:"cluster_type"`
+	Routes      []alertRoutingEntry `tfsdk:"routes"`
+}
+
+type alertRoutingEntry struct {
+	Type            types.String        `tfsdk:"type"`
+	OverrideInfo    types.Bool          `tfsdk:"override_info"`
+	OverrideWarning types.Bool          `tfsdk:"override_warning"`
+	OverrideError   types.Bool          `tfsdk:"override_error"`
+	Routing         []alertRoutingRoute `tfsdk:"routing"`
+}
+
+type alertRoutingRoute struct {
+	IntegrationID types.String `tfsdk:"integration_id"`
+	Severity      types.String `tfsdk:"severity"`
+}
+
+// terraformRouteType maps an API route type (e.g. "Service Checks") back to its Terraform name
+func terraformRouteType(apiType string) string {
+	for tfType, encoded := range routeTypeMap {
+		if strings.ReplaceAll(encoded, "%20", " ") == apiType {
+			return tfType
+		}
+	}
+	return apiType
+}
+
+func (d *alertRoutesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data alertRoutesDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrations, err := d.client.GetIntegrations(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
+		return
+	}
+
+	routes := []alertRoutingEntry{}
+	for _, routing := range integrations.Routings {
+		routingRoutes := []alertRoutingRoute{}
+		for _, route := range routing.Routing {
+			routingRoutes = append(routingRoutes, alertRoutingRoute{
+				IntegrationID: types.StringValue(route.ID),
+				Severity:      types.StringValue(strings.ToLower(route.Severity)),
+			})
+		}
+		routes = append(routes, alertRoutingEntry{
+			Type:            types.StringValue(terraformRouteType(routing.Type)),
+			OverrideInfo:    types.BoolValue(routing.OverrideInfo),
+			OverrideWarning: types.BoolValue(routing.OverrideWarning),
+			OverrideError:   types.BoolValue(routing.OverrideError),
+			Routing:         routingRoutes,
+		})
+	}
+	data.Routes = routes
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*cassandraAdaptiveRepairStatusDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*cassandraAdaptiveRepairStatusDataSource)(nil)
+
+type cassandraAdaptiveRepairStatusDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewCassandraAdaptiveRepairStatusDataSource() datasource.DataSource {
+	return &cassandraAdaptiveRepairStatusDataSource{}
+}
+
+func (d *cassandraAdaptiveRepairStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *cassandraAdaptiveRepairStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cassandra_adaptive_repair_status"
+}
+
+func (d *cassandraAdaptiveRepairStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the progress of the adaptive repair currently running on a Cassandra cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The cluster type (cassandra or dse). Default: cassandra",
+			},
+			"is_running": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether a repair is currently running.",
+			},
+			"current_keyspace": schema.StringAttribute{
+				Computed:    true,
+				Description: "The keyspace being repaired.",
+			},
+			"current_table": schema.StringAttribute{
+				Computed:    true,
+				Description: "The table being repaired.",
+			},
+			"percent_complete": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Progress of the current repair, from 0 to 100.",
+			},
+			"estimated_completion_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "Estimated time at which the current repair completes.",
+			},
+			"segments_completed": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of segments repaired so far.",
+			},
+			"segments_total": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Total number of segments in the current repair.",
+			},
+		},
+	}
+}
+
+type cassandraAdaptiveRepairStatusDataSourceData struct {
+	ClusterName             types.String  `tfsdk:"cluster_name"`
+	ClusterType             types.String  `tfsdk:"cluster_type"`
+	IsRunning               types.Bool    `tfsdk:"is_running"`
+	CurrentKeyspace         types.String  `tfsdk:"current_keyspace"`
+	CurrentTable            types.String  `tfsdk:"current_table"`
+	PercentComplete         types.Float64 `tfsdk:"percent_complete"`
+	EstimatedCompletionTime types.String  `tfsdk:"estimated_completion_time"`
+	SegmentsCompleted       types.Int64   `tfsdk:"segments_completed"`
+	SegmentsTotal           types.Int64   `tfsdk:"segments_total"`
+}
+
+func (d *cassandraAdaptiveRepairStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data cassandraAdaptiveRepairStatusDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterType := data.ClusterType.ValueString()
+	if clusterType == "" {
+		clusterType = "cassandra"
+	}
+
+	status, err := d.client.GetAdaptiveRepairStatus(ctx, clusterType, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read adaptive repair status: %s", err))
+		return
+	}
+
+	data.ClusterType = types.StringValue(clusterType)
+	data.IsRunning = types.BoolValue(status.IsRunning)
+	data.CurrentKeyspace = types.StringValue(status.CurrentKeyspace)
+	data.CurrentTable = types.StringValue(status.CurrentTable)
+	data.PercentComplete = types.Float64Value(status.PercentComplete)
+	data.EstimatedCompletionTime = types.StringValue(status.EstimatedCompletionTime)
+	data.SegmentsCompleted = types.Int64Value(int64(status.SegmentsCompleted))
+	data.SegmentsTotal = types.Int64Value(int64(status.SegmentsTotal))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
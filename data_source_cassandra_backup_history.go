@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*cassandraBackupHistoryDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*cassandraBackupHistoryDataSource)(nil)
+
+type cassandraBackupHistoryDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewCassandraBackupHistoryDataSource() datasource.DataSource {
+	return &cassandraBackupHistoryDataSource{}
+}
+
+func (d *cassandraBackupHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *cassandraBackupHistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cassandra_backup_history"
+}
+
+func (d *cassandraBackupHistoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Cassandra backups in the backup catalog of a cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The cluster type (cassandra or dse). Default: cassandra",
+			},
+			"tag_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return backups whose tag contains this string.",
+			},
+			"backups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The backups in the catalog.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the backup.",
+						},
+						"tag": schema.StringAttribute{
+							Computed:    true,
+							Description: "The backup tag.",
+						},
+						"datacenters": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "List of datacenters.",
+						},
+						"schedule": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether scheduling is enabled.",
+						},
+						"schedule_expr": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cron expression for backup schedule.",
+						},
+						"local_retention": schema.StringAttribute{
+							Computed:    true,
+							Description: "Local backup retention duration.",
+						},
+						"remote": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether remote backup is enabled.",
+						},
+						"remote_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Remote storage type.",
+						},
+						"remote_path": schema.StringAttribute{
+							Computed:    true,
+							Description: "Path on the remote storage.",
+						},
+						"remote_retention": schema.StringAttribute{
+							Computed:    true,
+							Description: "Remote backup retention duration.",
+						},
+						"timeout": schema.StringAttribute{
+							Computed:    true,
+							Description: "Backup operation timeout.",
+						},
+						"transfers": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of parallel transfers.",
+						},
+						"tps_limit": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Throughput per second limit.",
+						},
+						"bw_limit": schema.StringAttribute{
+							Computed:    true,
+							Description: "Bandwidth limit.",
+						},
+						"keyspaces": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Keyspaces to backup.",
+						},
+						"tables": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Tables to backup.",
+						},
+						"nodes": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Specific node IDs.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type cassandraBackupHistoryDataSourceData struct {
+	ClusterName types.String                  `tfsdk:"cluster_name"`
+	ClusterType types.String                  `tfsdk:"cluster_type"`
+	TagFilter   types.String                  `tfsdk:"tag_filter"`
+	Backups     []cassandraBackupHistoryEntry `tfsdk:"backups"`
+}
+
+type cassandraBackupHistoryEntry struct {
+	ID              types.String   `tfsdk:"id"`
+	Tag             types.String   `tfsdk:"tag"`
+	Datacenters     []types.String `tfsdk:"datacenters"`
+	Schedule        types.Bool     `tfsdk:"schedule"`
+	ScheduleExpr    types.String   `tfsdk:"schedule_expr"`
+	LocalRetention  types.String   `tfsdk:"local_retention"`
+	Remote          types.Bool     `tfsdk:"remote"`
+	RemoteType      types.String   `tfsdk:"remote_type"`
+	RemotePath      types.String   `tfsdk:"remote_path"`
+	RemoteRetention types.String   `tfsdk:"remote_retention"`
+	Timeout         types.String   `tfsdk:"timeout"`
+	Transfers       types.Int64    `tfsdk:"transfers"`
+	TpsLimit        types.Int64    `tfsdk:"tps_limit"`
+	BwLimit         types.String   `tfsdk:"bw_limit"`
+	Keyspaces       []types.String `tfsdk:"keyspaces"`
+	Tables          []types.String `tfsdk:"tables"`
+	Nodes           []types.String `tfsdk:"nodes"`
+}
+
+// stringValues converts a string slice to framework values, never returning nil
+func stringValues(values []string) []types.String {
+	result := []types.String{}
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
+
+func (d *cassandraBackupHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data cassandraBackupHistoryDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterType := data.ClusterType.ValueString()
+	if clusterType == "" {
+		clusterType = "cassandra"
+	}
+
+	backups, err := d.client.GetCassandraBackups(ctx, clusterType, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backups: %s", err))
+		return
+	}
+
+	entries := []cassandraBackupHistoryEntry{}
+	for _, b := range backups {
+		if !strings.Contains(b.Tag, data.TagFilter.ValueString()) {
+			continue
+		}
+		entries = append(entries, cassandraBackupHistoryEntry{
+			ID:              types.StringValue(b.ID),
+			Tag:             types.StringValue(b.Tag),
+			Datacenters:     stringValues(b.Datacenters),
+			Schedule:        types.BoolValue(b.Schedule),
+			ScheduleExpr:    types.StringValue(b.ScheduleExpr),
+			LocalRetention:  types.StringValue(b.LocalRetentionDuration),
+			Remote:          types.BoolValue(b.Remote),
+			RemoteType:      types.StringValue(b.RemoteType),
+			RemotePath:      types.StringValue(b.RemotePath),
+			RemoteRetention: types.StringValue(b.RemoteRetentionDuration),
+			Timeout:         types.StringValue(b.Timeout),
+			Transfers:       types.Int64Value(int64(b.Transfers)),
+			TpsLimit:        types.Int64Value(int64(b.TpsLimit)),
+			BwLimit:         types.StringValue(b.BwLimit),
+			Keyspaces:       stringValues(b.Keyspaces),
+			Tables:          stringValues(b.Tables),
+			Nodes:           stringValues(b.Nodes),
+		})
+	}
+
+	data.ClusterType = types.StringValue(clusterType)
+	data.Backups = entries
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*cassandraRepairHistoryDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*cassandraRepairHistoryDataSource)(nil)
+
+type cassandraRepairHistoryDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewCassandraRepairHistoryDataSource() datasource.DataSource {
+	return &cassandraRepairHistoryDataSource{}
+}
+
+func (d *cassandraRepairHistoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *cassandraRepairHistoryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cassandra_repair_history"
+}
+
+func (d *cassandraRepairHistoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the recent repair runs of a Cassandra cluster, e.g. to check that the last repair succeeded within the expected window.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The cluster type (cassandra or dse). Default: cassandra",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of repair runs to return. Default: 100",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return repair runs started at or after this time (RFC3339, e.g. 2024-01-01T00:00:00Z).",
+			},
+			"end_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return repair runs started at or before this time (RFC3339).",
+			},
+			"history": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The repair runs, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the repair run.",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the repair started.",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the repair finished, empty while it is running.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the repair: success, failed or running.",
+						},
+						"keyspace": schema.StringAttribute{
+							Computed:    true,
+							Description: "The keyspace repaired.",
+						},
+						"table": schema.StringAttribute{
+							Computed:    true,
+							Description: "The table repaired.",
+						},
+						"datacenter": schema.StringAttribute{
+							Computed:    true,
+							Description: "The datacenter repaired.",
+						},
+						"progress_percent": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Progress of the repair, from 0 to 100.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type cassandraRepairHistoryDataSourceData struct {
+	ClusterName types.String        `tfsdk:"cluster_name"`
+	ClusterType types.String        `tfsdk:"cluster_type"`
+	Limit       types.Int64         `tfsdk:"limit"`
+	StartTime   types.String        `tfsdk:"start_time"`
+	EndTime     types.String        `tfsdk:"end_time"`
+	History     []repairHistoryItem `tfsdk:"history"`
+}
+
+type repairHistoryItem struct {
+	ID              types.String  `tfsdk:"id"`
+	StartTime       types.String  `tfsdk:"start_time"`
+	EndTime         types.String  `tfsdk:"end_time"`
+	Status          types.String  `tfsdk:"status"`
+	Keyspace        types.String  `tfsdk:"keyspace"`
+	Table           types.String  `tfsdk:"table"`
+	Datacenter      types.String  `tfsdk:"datacenter"`
+	ProgressPercent types.Float64 `tfsdk:"progress_percent"`
+}
+
+func (d *cassandraRepairHistoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data cassandraRepairHistoryDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterType := data.ClusterType.ValueString()
+	if clusterType == "" {
+		clusterType = "cassandra"
+	}
+
+	limit := int64(100)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	var start, end time.Time
+	if !data.StartTime.IsNull() {
+		parsed, err := time.Parse(time.RFC3339, data.StartTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("start_time"), "Invalid Timestamp", fmt.Sprintf("start_time must be an RFC3339 timestamp (e.g., 2024-01-01T00:00:00Z), got: %s", data.StartTime.ValueString()))
+			return
+		}
+		start = parsed
+	}
+	if !data.EndTime.IsNull() {
+		parsed, err := time.Parse(time.RFC3339, data.EndTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("end_time"), "Invalid Timestamp", fmt.Sprintf("end_time must be an RFC3339 timestamp (e.g., 2024-01-01T00:00:00Z), got: %s", data.EndTime.ValueString()))
+			return
+		}
+		end = parsed
+	}
+
+	entries, err := d.client.GetRepairHistory(ctx, clusterType, data.ClusterName.ValueString(), int(limit), start, end)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repair history: %s", err))
+		return
+	}
+
+	history := []repairHistoryItem{}
+	for _, entry := range entries {
+		history = append(history, repairHistoryItem{
+			ID:              types.StringValue(entry.ID),
+			StartTime:       types.StringValue(entry.StartTime),
+			EndTime:         types.StringValue(entry.EndTime),
+			Status:          types.StringValue(entry.Status),
+			Keyspace:        types.StringValue(entry.Keyspace),
+			Table:           types.StringValue(entry.Table),
+			Datacenter:      types.StringValue(entry.Datacenter),
+			ProgressPercent: types.Float64Value(entry.ProgressPercent),
+		})
+	}
+
+	data.ClusterType = types.StringValue(clusterType)
+	data.Limit = types.Int64Value(limit)
+	data.History = history
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*connectorStatusDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*connectorStatusDataSource)(nil)
+
+type connectorStatusDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewConnectorStatusDataSource() datasource.DataSource {
+	return &connectorStatusDataSource{}
+}
+
+func (d *connectorStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *connectorStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connector_status"
+}
+
+func (d *connectorStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the running state of a Kafka Connect connector and its tasks.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"connect_cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka Connect cluster.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the connector.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The connector state (RUNNING, PAUSED, FAILED, UNASSIGNED).",
+			},
+			"worker_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The worker running the connector.",
+			},
+			"tasks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The state of each connector task.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The task ID.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The task state.",
+						},
+						"worker_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The worker running the task.",
+						},
+						"trace": schema.StringAttribute{
+							Computed:    true,
+							Description: "The stack trace of a failed task, empty otherwise.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type connectorStatusDataSourceData struct {
+	ClusterName        types.String               `tfsdk:"cluster_name"`
+	ConnectClusterName types.String               `tfsdk:"connect_cluster_name"`
+	Name               types.String               `tfsdk:"name"`
+	State              types.String               `tfsdk:"state"`
+	WorkerId           types.String               `tfsdk:"worker_id"`
+	Tasks              []connectorTaskStatusEntry `tfsdk:"tasks"`
+}
+
+type connectorTaskStatusEntry struct {
+	Id       types.Int64  `tfsdk:"id"`
+	State    types.String `tfsdk:"state"`
+	WorkerId types.String `tfsdk:"worker_id"`
+	Trace    types.String `tfsdk:"trace"`
+}
+
+func (d *connectorStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data connectorStatusDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, err := d.client.GetConnectorStatus(ctx, data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connector status: %s", err))
+		return
+	}
+
+	if status == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Connector %s not found in cluster %s/%s", data.Name.ValueString(), data.ClusterName.ValueString(), data.ConnectClusterName.ValueString()))
+		return
+	}
+
+	data.State = types.StringValue(status.Connector.State)
+	data.WorkerId = types.StringValue(status.Connector.WorkerId)
+
+	tasks := []connectorTaskStatusEntry{}
+	for _, task := range status.Tasks {
+		tasks = append(tasks, connectorTaskStatusEntry{
+			Id:       types.Int64Value(int64(task.Id)),
+			State:    types.StringValue(task.State),
+			WorkerId: types.StringValue(task.WorkerId),
+			Trace:    types.StringValue(task.Trace),
+		})
+	}
+	data.Tasks = tasks
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*consumerGroupDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*consumerGroupDataSource)(nil)
+
+type consumerGroupDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewConsumerGroupDataSource() datasource.DataSource {
+	return &consumerGroupDataSource{}
+}
+
+func (d *consumerGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *consumerGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_consumer_group"
+}
+
+func (d *consumerGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the membership and lag of a Kafka consumer group.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The consumer group ID.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The group state (e.g., Stable, Empty, PreparingRebalance).",
+			},
+			"members": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The active members of the group.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"member_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The member ID assigned by the group coordinator.",
+						},
+						"client_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The client ID of the consumer.",
+						},
+						"host": schema.StringAttribute{
+							Computed:    true,
+							Description: "The host the consumer connects from.",
+						},
+						"assigned_partitions": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The topic partitions assigned to the member.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"topic": schema.StringAttribute{
+										Computed:    true,
+										Description: "The topic name.",
+									},
+									"partition": schema.Int32Attribute{
+										Computed:    true,
+										Description: "The partition number.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"lag": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.MapType{ElemType: types.Int64Type},
+				Description: "Lag per topic, then per partition number (e.g., lag[\"orders\"][\"0\"]).",
+			},
+		},
+	}
+}
+
+type consumerGroupDataSourceData struct {
+	ClusterName types.String                      `tfsdk:"cluster_name"`
+	GroupID     types.String                      `tfsdk:"group_id"`
+	State       types.String                      `tfsdk:"state"`
+	Members     []consumerGroupMemberEntry        `tfsdk:"members"`
+	Lag         map[string]map[string]types.Int64 `tfsdk:"lag"`
+}
+
+type consumerGroupMemberEntry struct {
+	MemberID           types.String                  `tfsdk:"member_id"`
+	ClientID           types.String                  `tfsdk:"client_id"`
+	Host               types.String                  `tfsdk:"host"`
+	AssignedPartitions []consumerGroupPartitionEntry `tfsdk:"assigned_partitions"`
+}
+
+type consumerGroupPartitionEntry struct {
+	Topic     types.String `tfsdk:"topic"`
+	Partition types.Int32  `tfsdk:"partition"`
+}
+
+// flattenConsumerGroupMembers converts the API members into data source entries
+func flattenConsumerGroupMembers(members []axonopsClient.ConsumerGroupMember) []consumerGroupMemberEntry {
+	entries := []consumerGroupMemberEntry{}
+	for _, member := range members {
+		partitions := []consumerGroupPartitionEntry{}
+		for _, p := range member.AssignedPartitions {
+			partitions = append(partitions, consumerGroupPartitionEntry{
+				Topic:     types.StringValue(p.Topic),
+				Partition: types.Int32Value(p.Partition),
+			})
+		}
+		entries = append(entries, consumerGroupMemberEntry{
+			MemberID:           types.StringValue(member.MemberID),
+			ClientID:           types.StringValue(member.ClientID),
+			Host:               types.StringValue(member.Host),
+			AssignedPartitions: partitions,
+		})
+	}
+	return entries
+}
+
+// flattenConsumerGroupLag groups partition offsets by topic
+func flattenConsumerGroupLag(offsets []axonopsClient.ConsumerGroupOffset) map[string]map[string]types.Int64 {
+	lag := make(map[string]map[string]types.Int64)
+	for _, offset := range offsets {
+		if lag[offset.Topic] == nil {
+			lag[offset.Topic] = make(map[string]types.Int64)
+		}
+		lag[offset.Topic][strconv.Itoa(int(offset.Partition))] = types.Int64Value(offset.Lag)
+	}
+	return lag
+}
+
+func (d *consumerGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data consumerGroupDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := d.client.GetConsumerGroup(ctx, data.ClusterName.ValueString(), data.GroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read consumer group: %s", err))
+		return
+	}
+
+	if group == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Consumer group %s not found in cluster %s", data.GroupID.ValueString(), data.ClusterName.ValueString()))
+		return
+	}
+
+	data.State = types.StringValue(group.State)
+	data.Members = flattenConsumerGroupMembers(group.Members)
+	data.Lag = flattenConsumerGroupLag(group.Offsets)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*consumerGroupsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*consumerGroupsDataSource)(nil)
+
+type consumerGroupsDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewConsumerGroupsDataSource() datasource.DataSource {
+	return &consumerGroupsDataSource{}
+}
+
+func (d *consumerGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *consumerGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_consumer_groups"
+}
+
+func (d *consumerGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all consumer groups in a Kafka cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of consumer groups in the cluster.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The consumer group ID.",
+						},
+						"state": schema.StringAttribute{
+							Computed:    true,
+							Description: "The group state.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type consumerGroupsDataSourceData struct {
+	ClusterName types.String             `tfsdk:"cluster_name"`
+	Groups      []consumerGroupListEntry `tfsdk:"groups"`
+}
+
+type consumerGroupListEntry struct {
+	GroupID types.String `tfsdk:"group_id"`
+	State   types.String `tfsdk:"state"`
+}
+
+func (d *consumerGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data consumerGroupsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := d.client.GetConsumerGroups(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list consumer groups: %s", err))
+		return
+	}
+
+	entries := []consumerGroupListEntry{}
+	for _, group := range groups {
+		entries = append(entries, consumerGroupListEntry{
+			GroupID: types.StringValue(group.GroupID),
+			State:   types.StringValue(group.State),
+		})
+	}
+	data.Groups = entries
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*dseWorkloadDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*dseWorkloadDataSource)(nil)
+
+type dseWorkloadDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewDseWorkloadDataSource() datasource.DataSource {
+	return &dseWorkloadDataSource{}
+}
+
+func (d *dseWorkloadDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dseWorkloadDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dse_workload"
+}
+
+func (d *dseWorkloadDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the workload type of a node of a DataStax Enterprise cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the DSE cluster.",
+			},
+			"node_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the node.",
+			},
+			"workload_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The workload type of the node (Analytics, Search, Graph, CassandraDB or SearchAnalytics).",
+			},
+		},
+	}
+}
+
+type dseWorkloadDataSourceData struct {
+	ClusterName  types.String `tfsdk:"cluster_name"`
+	NodeID       types.String `tfsdk:"node_id"`
+	WorkloadType types.String `tfsdk:"workload_type"`
+}
+
+func (d *dseWorkloadDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dseWorkloadDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workloadType, err := d.client.GetNodeWorkload(ctx, data.ClusterName.ValueString(), data.NodeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node workload: %s", err))
+		return
+	}
+
+	data.WorkloadType = types.StringValue(workloadType)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
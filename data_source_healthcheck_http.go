@@ -49,7 +49,12 @@ func (d *httpHealthcheckDataSource) Schema(ctx context.Context, req datasource.S
 		Attributes: map[string]schema.Attribute{
 			"cluster_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The name of the Kafka cluster.",
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The cluster type (kafka, cassandra, or dse). Default: kafka",
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -88,6 +93,10 @@ func (d *httpHealthcheckDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:    true,
 				Description: "The timeout for the check.",
 			},
+			"tls_skip_verify": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether verification of the TLS certificate of url is skipped.",
+			},
 			"readonly": schema.BoolAttribute{
 				Computed:    true,
 				Description: "Whether the healthcheck is read-only.",
@@ -103,6 +112,7 @@ func (d *httpHealthcheckDataSource) Schema(ctx context.Context, req datasource.S
 
 type httpHealthcheckDataSourceData struct {
 	ClusterName         types.String `tfsdk:"cluster_name"`
+	ClusterType         types.String `tfsdk:"cluster_type"`
 	Name                types.String `tfsdk:"name"`
 	ID                  types.String `tfsdk:"id"`
 	URL                 types.String `tfsdk:"url"`
@@ -110,6 +120,7 @@ type httpHealthcheckDataSourceData struct {
 	Headers             types.Map    `tfsdk:"headers"`
 	Body                types.String `tfsdk:"body"`
 	ExpectedStatus      types.Int64  `tfsdk:"expected_status"`
+	TLSSkipVerify       types.Bool   `tfsdk:"tls_skip_verify"`
 	Interval            types.String `tfsdk:"interval"`
 	Timeout             types.String `tfsdk:"timeout"`
 	Readonly            types.Bool   `tfsdk:"readonly"`
@@ -125,7 +136,13 @@ func (d *httpHealthcheckDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	healthchecks, err := d.client.GetHealthchecks(data.ClusterName.ValueString())
+	clusterType := data.ClusterType.ValueString()
+	if clusterType == "" {
+		clusterType = "kafka"
+	}
+	data.ClusterType = types.StringValue(clusterType)
+
+	healthchecks, err := d.client.GetHealthchecks(ctx, clusterType, data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read healthchecks: %s", err))
 		return
@@ -149,6 +166,7 @@ func (d *httpHealthcheckDataSource) Read(ctx context.Context, req datasource.Rea
 	data.Method = types.StringValue(found.Method)
 	data.Body = types.StringValue(found.Body)
 	data.ExpectedStatus = types.Int64Value(int64(found.ExpectedStatus))
+	data.TLSSkipVerify = types.BoolValue(found.TLSSkipVerify)
 	data.Interval = types.StringValue(found.Interval)
 	data.Timeout = types.StringValue(found.Timeout)
 	data.Readonly = types.BoolValue(found.Readonly)
@@ -49,7 +49,12 @@ func (d *shellHealthcheckDataSource) Schema(ctx context.Context, req datasource.
 		Attributes: map[string]schema.Attribute{
 			"cluster_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The name of the Kafka cluster.",
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The cluster type (kafka, cassandra, or dse). Default: kafka",
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -85,6 +90,7 @@ func (d *shellHealthcheckDataSource) Schema(ctx context.Context, req datasource.
 
 type shellHealthcheckDataSourceData struct {
 	ClusterName types.String `tfsdk:"cluster_name"`
+	ClusterType types.String `tfsdk:"cluster_type"`
 	Name        types.String `tfsdk:"name"`
 	ID          types.String `tfsdk:"id"`
 	Script      types.String `tfsdk:"script"`
@@ -103,7 +109,13 @@ func (d *shellHealthcheckDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	healthchecks, err := d.client.GetHealthchecks(data.ClusterName.ValueString())
+	clusterType := data.ClusterType.ValueString()
+	if clusterType == "" {
+		clusterType = "kafka"
+	}
+	data.ClusterType = types.StringValue(clusterType)
+
+	healthchecks, err := d.client.GetHealthchecks(ctx, clusterType, data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read healthchecks: %s", err))
 		return
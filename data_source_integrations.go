@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*integrationsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*integrationsDataSource)(nil)
+
+type integrationsDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &integrationsDataSource{}
+}
+
+func (d *integrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *integrationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+func (d *integrationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the integrations alerts can be routed to on a cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+				Validators: []validator.String{
+					stringvalidator.OneOf("cassandra", "kafka", "dse"),
+				},
+			},
+			"definitions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The integrations defined on the cluster.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the integration.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of integration (e.g., slack, pagerduty).",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the integration, as used by axonops_alert_route integration_name.",
+						},
+						"params": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "Type-specific settings, which can include secrets such as webhook URLs.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type integrationsDataSourceData struct {
+	ClusterName types.String                 `tfsdk:"cluster_name"`
+	ClusterType types.String                 `tfsdk:"cluster_type"`
+	Definitions []integrationDefinitionEntry `tfsdk:"definitions"`
+}
+
+type integrationDefinitionEntry struct {
+	ID     types.String `tfsdk:"id"`
+	Type   types.String `tfsdk:"type"`
+	Name   types.String `tfsdk:"name"`
+	Params types.Map    `tfsdk:"params"`
+}
+
+func (d *integrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data integrationsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrations, err := d.client.GetIntegrations(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integrations: %s", err))
+		return
+	}
+
+	entries := []integrationDefinitionEntry{}
+	for _, def := range integrations.Definitions {
+		params, diags := types.MapValueFrom(ctx, types.StringType, def.Params)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		entries = append(entries, integrationDefinitionEntry{
+			ID:     types.StringValue(def.ID),
+			Type:   types.StringValue(def.Type),
+			Name:   types.StringValue(def.Params["name"]),
+			Params: params,
+		})
+	}
+	data.Definitions = entries
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
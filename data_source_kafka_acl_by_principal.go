@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*kafkaACLByPrincipalDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kafkaACLByPrincipalDataSource)(nil)
+
+type kafkaACLByPrincipalDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaACLByPrincipalDataSource() datasource.DataSource {
+	return &kafkaACLByPrincipalDataSource{}
+}
+
+func (d *kafkaACLByPrincipalDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kafkaACLByPrincipalDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_acl_by_principal"
+}
+
+func (d *kafkaACLByPrincipalDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Kafka ACLs granted to a principal across all resources.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"principal": schema.StringAttribute{
+				Required:    true,
+				Description: "The principal to list ACLs for (e.g., User:alice).",
+			},
+			"acls": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of ACL entries for the principal.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of resource.",
+						},
+						"resource_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the resource.",
+						},
+						"resource_pattern_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The pattern type.",
+						},
+						"principal": schema.StringAttribute{
+							Computed:    true,
+							Description: "The principal.",
+						},
+						"host": schema.StringAttribute{
+							Computed:    true,
+							Description: "The host.",
+						},
+						"operation": schema.StringAttribute{
+							Computed:    true,
+							Description: "The operation.",
+						},
+						"permission_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The permission type.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type kafkaACLByPrincipalDataSourceData struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Principal   types.String `tfsdk:"principal"`
+	ACLs        []aclEntry   `tfsdk:"acls"`
+}
+
+func (d *kafkaACLByPrincipalDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data kafkaACLByPrincipalDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aclResponse, err := d.client.GetACLs(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACLs: %s", err))
+		return
+	}
+
+	// The API has no principal filter, so filter client-side
+	entries := []aclEntry{}
+	for _, res := range aclResponse.ACLResources {
+		for _, acl := range res.ACLs {
+			if acl.Principal != data.Principal.ValueString() {
+				continue
+			}
+			entries = append(entries, aclEntry{
+				ResourceType:        types.StringValue(res.ResourceType),
+				ResourceName:        types.StringValue(res.ResourceName),
+				ResourcePatternType: types.StringValue(res.ResourcePatternType),
+				Principal:           types.StringValue(acl.Principal),
+				Host:                types.StringValue(acl.Host),
+				Operation:           types.StringValue(acl.Operation),
+				PermissionType:      types.StringValue(acl.PermissionType),
+			})
+		}
+	}
+	data.ACLs = entries
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
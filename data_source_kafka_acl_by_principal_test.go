@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readACLsByPrincipal(t *testing.T, principal string, acls axonopsClient.ACLResponse) []aclEntry {
+	t.Helper()
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/test-org/kafka/prod/acls" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(acls)
+	}))
+
+	d := &kafkaACLByPrincipalDataSource{client: client}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := config.Set(context.Background(), &kafkaACLByPrincipalDataSourceData{
+		ClusterName: types.StringValue("prod"),
+		Principal:   types.StringValue(principal),
+	}); diags.HasError() {
+		t.Fatalf("unable to build config: %v", diags)
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var data kafkaACLByPrincipalDataSourceData
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+	return data.ACLs
+}
+
+func TestKafkaACLByPrincipalFiltersPrincipal(t *testing.T) {
+	acls := axonopsClient.ACLResponse{
+		ACLResources: []axonopsClient.ACLResource{
+			{
+				ResourceType:        "TOPIC",
+				ResourceName:        "orders",
+				ResourcePatternType: "LITERAL",
+				ACLs: []axonopsClient.KafkaACL{
+					{Principal: "User:alice", Host: "*", Operation: "READ", PermissionType: "ALLOW"},
+					{Principal: "User:bob", Host: "*", Operation: "WRITE", PermissionType: "ALLOW"},
+				},
+			},
+			{
+				ResourceType:        "GROUP",
+				ResourceName:        "billing-",
+				ResourcePatternType: "PREFIXED",
+				ACLs: []axonopsClient.KafkaACL{
+					{Principal: "User:alice", Host: "10.0.0.1", Operation: "DESCRIBE", PermissionType: "DENY"},
+				},
+			},
+			{
+				ResourceType:        "CLUSTER",
+				ResourceName:        "kafka-cluster",
+				ResourcePatternType: "LITERAL",
+				ACLs: []axonopsClient.KafkaACL{
+					// Principals are matched exactly
+					{Principal: "User:alice2", Host: "*", Operation: "ALTER", PermissionType: "ALLOW"},
+				},
+			},
+		},
+	}
+
+	want := []aclEntry{
+		{
+			ResourceType:        types.StringValue("TOPIC"),
+			ResourceName:        types.StringValue("orders"),
+			ResourcePatternType: types.StringValue("LITERAL"),
+			Principal:           types.StringValue("User:alice"),
+			Host:                types.StringValue("*"),
+			Operation:           types.StringValue("READ"),
+			PermissionType:      types.StringValue("ALLOW"),
+		},
+		{
+			ResourceType:        types.StringValue("GROUP"),
+			ResourceName:        types.StringValue("billing-"),
+			ResourcePatternType: types.StringValue("PREFIXED"),
+			Principal:           types.StringValue("User:alice"),
+			Host:                types.StringValue("10.0.0.1"),
+			Operation:           types.StringValue("DESCRIBE"),
+			PermissionType:      types.StringValue("DENY"),
+		},
+	}
+	if got := readACLsByPrincipal(t, "User:alice", acls); !reflect.DeepEqual(got, want) {
+		t.Errorf("acls = %v, want %v", got, want)
+	}
+}
+
+func TestKafkaACLByPrincipalNoMatches(t *testing.T) {
+	acls := axonopsClient.ACLResponse{
+		ACLResources: []axonopsClient.ACLResource{
+			{
+				ResourceType:        "TOPIC",
+				ResourceName:        "orders",
+				ResourcePatternType: "LITERAL",
+				ACLs:                []axonopsClient.KafkaACL{{Principal: "User:bob", Host: "*", Operation: "WRITE", PermissionType: "ALLOW"}},
+			},
+		},
+	}
+
+	got := readACLsByPrincipal(t, "User:alice", acls)
+	if got == nil || len(got) != 0 {
+		t.Errorf("acls = %v, want an empty list", got)
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*kafkaACLsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kafkaACLsDataSource)(nil)
+
+type kafkaACLsDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaACLsDataSource() datasource.DataSource {
+	return &kafkaACLsDataSource{}
+}
+
+func (d *kafkaACLsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kafkaACLsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_acls"
+}
+
+func (d *kafkaACLsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Kafka ACLs of a cluster grouped by resource, optionally filtered, for auditing ACLs without managing them.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"principal_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return ACLs granted to this principal (e.g., User:alice).",
+			},
+			"resource_type_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return ACLs on this resource type (e.g., TOPIC, GROUP, CLUSTER).",
+			},
+			"permission_type_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return ACLs with this permission type: ALLOW or DENY.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("ALLOW", "DENY"),
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The resources with at least one matching ACL.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of resource.",
+						},
+						"resource_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the resource.",
+						},
+						"resource_pattern_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The pattern type.",
+						},
+						"acls": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The matching ACL entries on the resource.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"principal": schema.StringAttribute{
+										Computed:    true,
+										Description: "The principal.",
+									},
+									"host": schema.StringAttribute{
+										Computed:    true,
+										Description: "The host.",
+									},
+									"operation": schema.StringAttribute{
+										Computed:    true,
+										Description: "The operation.",
+									},
+									"permission_type": schema.StringAttribute{
+										Computed:    true,
+										Description: "The permission type.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type kafkaACLsDataSourceData struct {
+	ClusterName          types.String       `tfsdk:"cluster_name"`
+	PrincipalFilter      types.String       `tfsdk:"principal_filter"`
+	ResourceTypeFilter   types.String       `tfsdk:"resource_type_filter"`
+	PermissionTypeFilter types.String       `tfsdk:"permission_type_filter"`
+	Resources            []aclResourceEntry `tfsdk:"resources"`
+}
+
+type aclResourceEntry struct {
+	ResourceType        types.String       `tfsdk:"resource_type"`
+	ResourceName        types.String       `tfsdk:"resource_name"`
+	ResourcePatternType types.String       `tfsdk:"resource_pattern_type"`
+	ACLs                []aclResourceGrant `tfsdk:"acls"`
+}
+
+type aclResourceGrant struct {
+	Principal      types.String `tfsdk:"principal"`
+	Host           types.String `tfsdk:"host"`
+	Operation      types.String `tfsdk:"operation"`
+	PermissionType types.String `tfsdk:"permission_type"`
+}
+
+func (d *kafkaACLsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data kafkaACLsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aclResponse, err := d.client.GetACLs(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACLs: %s", err))
+		return
+	}
+
+	// The API has no filters, so filter client-side
+	resources := []aclResourceEntry{}
+	for _, res := range aclResponse.ACLResources {
+		if !data.ResourceTypeFilter.IsNull() && !strings.EqualFold(res.ResourceType, data.ResourceTypeFilter.ValueString()) {
+			continue
+		}
+
+		grants := []aclResourceGrant{}
+		for _, acl := range res.ACLs {
+			if !data.PrincipalFilter.IsNull() && acl.Principal != data.PrincipalFilter.ValueString() {
+				continue
+			}
+			if !data.PermissionTypeFilter.IsNull() && !strings.EqualFold(acl.PermissionType, data.PermissionTypeFilter.ValueString()) {
+				continue
+			}
+			grants = append(grants, aclResourceGrant{
+				Principal:      types.StringValue(acl.Principal),
+				Host:           types.StringValue(acl.Host),
+				Operation:      types.StringValue(acl.Operation),
+				PermissionType: types.StringValue(acl.PermissionType),
+			})
+		}
+		if len(grants) == 0 {
+			continue
+		}
+
+		resources = append(resources, aclResourceEntry{
+			ResourceType:        types.StringValue(res.ResourceType),
+			ResourceName:        types.StringValue(res.ResourceName),
+			ResourcePatternType: types.StringValue(res.ResourcePatternType),
+			ACLs:                grants,
+		})
+	}
+	data.Resources = resources
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
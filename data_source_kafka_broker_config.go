@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*kafkaBrokerConfigDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kafkaBrokerConfigDataSource)(nil)
+
+type kafkaBrokerConfigDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaBrokerConfigDataSource() datasource.DataSource {
+	return &kafkaBrokerConfigDataSource{}
+}
+
+func (d *kafkaBrokerConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kafkaBrokerConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_broker_config"
+}
+
+func (d *kafkaBrokerConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the configuration of a Kafka broker.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"broker_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the broker. Default: the broker with the lowest ID.",
+			},
+			"explicit_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return configs explicitly set on the broker, leaving out defaults. Default: false",
+			},
+			"config": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The broker configs keyed by name (e.g., log.retention.ms).",
+			},
+		},
+	}
+}
+
+type kafkaBrokerConfigDataSourceData struct {
+	ClusterName  types.String `tfsdk:"cluster_name"`
+	BrokerID     types.Int64  `tfsdk:"broker_id"`
+	ExplicitOnly types.Bool   `tfsdk:"explicit_only"`
+	Config       types.Map    `tfsdk:"config"`
+}
+
+func (d *kafkaBrokerConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data kafkaBrokerConfigDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := data.ClusterName.ValueString()
+
+	if data.BrokerID.IsNull() {
+		brokers, err := d.client.GetBrokers(ctx, clusterName)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read brokers: %s", err))
+			return
+		}
+
+		if len(brokers) == 0 {
+			resp.Diagnostics.AddError("Not Found", fmt.Sprintf("No brokers found in cluster %s", clusterName))
+			return
+		}
+
+		brokerID := brokers[0].ID
+		for _, broker := range brokers[1:] {
+			if broker.ID < brokerID {
+				brokerID = broker.ID
+			}
+		}
+		data.BrokerID = types.Int64Value(int64(brokerID))
+	}
+
+	entries, err := d.client.GetBrokerConfigEntries(ctx, clusterName, int(data.BrokerID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read broker config: %s", err))
+		return
+	}
+
+	config := make(map[string]string)
+	for _, entry := range entries {
+		if data.ExplicitOnly.ValueBool() && !entry.IsExplicitlySet {
+			continue
+		}
+		config[entry.Name] = entry.Value
+	}
+
+	data.Config, diags = types.MapValueFrom(ctx, types.StringType, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*kafkaClusterInfoDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kafkaClusterInfoDataSource)(nil)
+
+type kafkaClusterInfoDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaClusterInfoDataSource() datasource.DataSource {
+	return &kafkaClusterInfoDataSource{}
+}
+
+func (d *kafkaClusterInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kafkaClusterInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_cluster_info"
+}
+
+func (d *kafkaClusterInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the brokers of a Kafka cluster, for topology-aware configuration such as sizing replication factors.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"broker_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of brokers in the cluster.",
+			},
+			"kafka_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Kafka version running on the cluster (e.g., 3.6.1).",
+			},
+			"controller_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the active controller.",
+			},
+			"brokers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The brokers, sorted by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The broker ID.",
+						},
+						"host": schema.StringAttribute{
+							Computed:    true,
+							Description: "The hostname of the broker.",
+						},
+						"port": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The port of the broker.",
+						},
+						"rack": schema.StringAttribute{
+							Computed:    true,
+							Description: "The rack of the broker, or null if broker.rack is not set.",
+						},
+						"is_controller": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the broker is the active controller.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type kafkaClusterInfoDataSourceData struct {
+	ClusterName  types.String       `tfsdk:"cluster_name"`
+	BrokerCount  types.Int64        `tfsdk:"broker_count"`
+	KafkaVersion types.String       `tfsdk:"kafka_version"`
+	ControllerID types.Int64        `tfsdk:"controller_id"`
+	Brokers      []kafkaBrokerEntry `tfsdk:"brokers"`
+}
+
+type kafkaBrokerEntry struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Host         types.String `tfsdk:"host"`
+	Port         types.Int64  `tfsdk:"port"`
+	Rack         types.String `tfsdk:"rack"`
+	IsController types.Bool   `tfsdk:"is_controller"`
+}
+
+func (d *kafkaClusterInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data kafkaClusterInfoDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.GetKafkaClusterInfo(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster info: %s", err))
+		return
+	}
+
+	brokers := []kafkaBrokerEntry{}
+	for _, b := range info.Brokers {
+		rack := types.StringNull()
+		if b.Rack != "" {
+			rack = types.StringValue(b.Rack)
+		}
+
+		brokers = append(brokers, kafkaBrokerEntry{
+			ID:           types.Int64Value(int64(b.ID)),
+			Host:         types.StringValue(b.Host),
+			Port:         types.Int64Value(int64(b.Port)),
+			Rack:         rack,
+			IsController: types.BoolValue(b.ID == info.ControllerID),
+		})
+	}
+
+	sort.Slice(brokers, func(i, j int) bool {
+		return brokers[i].ID.ValueInt64() < brokers[j].ID.ValueInt64()
+	})
+
+	data.BrokerCount = types.Int64Value(int64(len(brokers)))
+	data.KafkaVersion = types.StringValue(info.KafkaVersion)
+	data.ControllerID = types.Int64Value(int64(info.ControllerID))
+	data.Brokers = brokers
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
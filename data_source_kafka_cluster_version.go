@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*kafkaVersionDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kafkaVersionDataSource)(nil)
+
+type kafkaVersionDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaClusterVersionDataSource() datasource.DataSource {
+	return &kafkaVersionDataSource{}
+}
+
+func (d *kafkaVersionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kafkaVersionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_cluster_version"
+}
+
+func (d *kafkaVersionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the Kafka version of a cluster, for gating version-specific features.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"broker_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Kafka broker version (e.g., 3.6.1).",
+			},
+			"protocol_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The inter-broker protocol version.",
+			},
+			"log_message_format_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The log message format version.",
+			},
+			"kraft_mode": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the cluster runs in KRaft mode rather than with ZooKeeper.",
+			},
+		},
+	}
+}
+
+type kafkaVersionDataSourceData struct {
+	ClusterName             types.String `tfsdk:"cluster_name"`
+	BrokerVersion           types.String `tfsdk:"broker_version"`
+	ProtocolVersion         types.String `tfsdk:"protocol_version"`
+	LogMessageFormatVersion types.String `tfsdk:"log_message_format_version"`
+	KRaftMode               types.Bool   `tfsdk:"kraft_mode"`
+}
+
+func (d *kafkaVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data kafkaVersionDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	version, err := d.client.GetKafkaVersion(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Kafka version: %s", err))
+		return
+	}
+
+	data.BrokerVersion = types.StringValue(version.BrokerVersion)
+	data.ProtocolVersion = types.StringValue(version.ProtocolVersion)
+	data.LogMessageFormatVersion = types.StringValue(version.LogMessageFormatVersion)
+	data.KRaftMode = types.BoolValue(version.KRaftMode)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -89,7 +89,7 @@ func (d *connectorDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	result, err := d.client.GetConnector(data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
+	result, err := d.client.GetConnector(ctx, data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connector: %s", err))
 		return
@@ -101,10 +101,7 @@ func (d *connectorDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	config := make(map[string]types.String)
-	for key, value := range result.Config {
-		if key == "name" {
-			continue
-		}
+	for key, value := range axonopsClient.FilterConnectorConfig(result.Config) {
 		config[key] = types.StringValue(value)
 	}
 	data.Config = config
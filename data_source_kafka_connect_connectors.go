@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*connectorsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*connectorsDataSource)(nil)
+
+type connectorsDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaConnectConnectorsDataSource() datasource.DataSource {
+	return &connectorsDataSource{}
+}
+
+func (d *connectorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *connectorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_connect_connectors"
+}
+
+func (d *connectorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Kafka Connect connectors of a Kafka cluster, across all its connect clusters or for a single one.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"connect_cluster_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the connectors of this Kafka Connect cluster. Lists the connectors of all connect clusters when not set.",
+			},
+			"connectors": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The connectors, sorted by connect cluster and name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the connector.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the connector (source or sink).",
+						},
+						"connect_cluster_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the Kafka Connect cluster the connector runs on.",
+						},
+						"config": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The connector configuration.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type connectorsDataSourceData struct {
+	ClusterName        types.String          `tfsdk:"cluster_name"`
+	ConnectClusterName types.String          `tfsdk:"connect_cluster_name"`
+	Connectors         []connectorsListEntry `tfsdk:"connectors"`
+}
+
+type connectorsListEntry struct {
+	Name               types.String            `tfsdk:"name"`
+	Type               types.String            `tfsdk:"type"`
+	ConnectClusterName types.String            `tfsdk:"connect_cluster_name"`
+	Config             map[string]types.String `tfsdk:"config"`
+}
+
+func (d *connectorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data connectorsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := data.ClusterName.ValueString()
+
+	connectClusters := []string{data.ConnectClusterName.ValueString()}
+	if data.ConnectClusterName.IsNull() {
+		names, err := d.client.GetConnectClusters(ctx, clusterName)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connect clusters: %s", err))
+			return
+		}
+		sort.Strings(names)
+		connectClusters = names
+	}
+
+	connectors := []connectorsListEntry{}
+	for _, connectCluster := range connectClusters {
+		entries, err := d.client.GetConnectors(ctx, clusterName, connectCluster)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connectors of connect cluster %s: %s", connectCluster, err))
+			return
+		}
+
+		names := make([]string, 0, len(entries))
+		for name := range entries {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			info := entries[name].Info
+
+			config := make(map[string]types.String)
+			for key, value := range axonopsClient.FilterConnectorConfig(info.Config) {
+				config[key] = types.StringValue(value)
+			}
+
+			connectors = append(connectors, connectorsListEntry{
+				Name:               types.StringValue(name),
+				Type:               types.StringValue(info.Type),
+				ConnectClusterName: types.StringValue(connectCluster),
+				Config:             config,
+			})
+		}
+	}
+	data.Connectors = connectors
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
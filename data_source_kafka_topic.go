@@ -3,7 +3,6 @@ package main
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	axonopsClient "terraform-provider-axonops/client"
 
@@ -90,18 +89,23 @@ func (d *topicDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	topic, err := d.client.GetTopic(data.Name.ValueString(), data.ClusterName.ValueString())
+	topic, err := d.client.GetTopic(ctx, data.Name.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read topic: %s", err))
 		return
 	}
 
+	if topic == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Topic %s not found in cluster %s", data.Name.ValueString(), data.ClusterName.ValueString()))
+		return
+	}
+
 	data.Partitions = types.Int32Value(topic.Partitions)
 	data.ReplicationFactor = types.Int32Value(topic.ReplicationFactor)
 
 	config := make(map[string]types.String)
 	for _, c := range topic.Config {
-		key := strings.ReplaceAll(c.Name, ".", "_")
+		key := topicConfigKey(c.Name)
 		config[key] = types.StringValue(c.Value)
 	}
 	data.Config = config
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"sort"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*topicConfigKeysDataSource)(nil)
+
+// topicConfigKeysDataSource lists the known topic configs, it doesn't call the API
+type topicConfigKeysDataSource struct{}
+
+func NewKafkaTopicConfigKeysDataSource() datasource.DataSource {
+	return &topicConfigKeysDataSource{}
+}
+
+func (d *topicConfigKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_topic_config_keys"
+}
+
+func (d *topicConfigKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Kafka topic configs accepted in the config map of axonops_kafka_topic.",
+		Attributes: map[string]schema.Attribute{
+			"config_keys": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The known topic configs, sorted by key.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The config map key (e.g., segment_bytes).",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Kafka config name (e.g., segment.bytes).",
+						},
+						"dedicated_attribute": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the config is set through its own attribute of axonops_kafka_topic rather than the config map.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type topicConfigKeysDataSourceData struct {
+	ConfigKeys []topicConfigKeyEntry `tfsdk:"config_keys"`
+}
+
+type topicConfigKeyEntry struct {
+	Key                types.String `tfsdk:"key"`
+	Name               types.String `tfsdk:"name"`
+	DedicatedAttribute types.Bool   `tfsdk:"dedicated_attribute"`
+}
+
+func (d *topicConfigKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	dedicated := make(map[string]bool)
+	for _, key := range topicDedicatedConfigKeys {
+		dedicated[key] = true
+	}
+
+	entries := []topicConfigKeyEntry{}
+	for key, name := range axonopsClient.TopicConfigKeyMap {
+		entries = append(entries, topicConfigKeyEntry{
+			Key:                types.StringValue(key),
+			Name:               types.StringValue(name),
+			DedicatedAttribute: types.BoolValue(dedicated[key]),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key.ValueString() < entries[j].Key.ValueString()
+	})
+
+	data := topicConfigKeysDataSourceData{ConfigKeys: entries}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
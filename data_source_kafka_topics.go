@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*topicsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*topicsDataSource)(nil)
+
+type topicsDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaTopicsDataSource() datasource.DataSource {
+	return &topicsDataSource{}
+}
+
+func (d *topicsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *topicsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_topics"
+}
+
+func (d *topicsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all topics in a Kafka cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"topics": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of topics in the cluster.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The topic name.",
+						},
+						"partitions": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of partitions.",
+						},
+						"replication_factor": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Replication factor.",
+						},
+						"config": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Explicitly set topic configuration (keys use underscores instead of dots).",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type topicsDataSourceData struct {
+	ClusterName types.String     `tfsdk:"cluster_name"`
+	Topics      []topicListEntry `tfsdk:"topics"`
+}
+
+type topicListEntry struct {
+	Name              types.String            `tfsdk:"name"`
+	Partitions        types.Int32             `tfsdk:"partitions"`
+	ReplicationFactor types.Int32             `tfsdk:"replication_factor"`
+	Config            map[string]types.String `tfsdk:"config"`
+}
+
+func (d *topicsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data topicsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topics, err := d.client.GetTopics(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list topics: %s", err))
+		return
+	}
+
+	entries := []topicListEntry{}
+	for _, topic := range topics {
+		configs, err := d.client.GetTopicConfigs(ctx, topic.Name, data.ClusterName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read configs of topic %s: %s", topic.Name, err))
+			return
+		}
+
+		config := make(map[string]types.String)
+		for _, c := range configs {
+			key := topicConfigKey(c.Name)
+			config[key] = types.StringValue(c.Value)
+		}
+
+		entries = append(entries, topicListEntry{
+			Name:              types.StringValue(topic.Name),
+			Partitions:        types.Int32Value(topic.Partitions),
+			ReplicationFactor: types.Int32Value(topic.ReplicationFactor),
+			Config:            config,
+		})
+	}
+	data.Topics = entries
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
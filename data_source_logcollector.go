@@ -49,7 +49,12 @@ func (d *logCollectorDataSource) Schema(ctx context.Context, req datasource.Sche
 		Attributes: map[string]schema.Attribute{
 			"cluster_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The name of the Kafka cluster.",
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The cluster type (kafka, cassandra, or dse). Default: kafka",
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -98,6 +103,7 @@ func (d *logCollectorDataSource) Schema(ctx context.Context, req datasource.Sche
 
 type logCollectorDataSourceData struct {
 	ClusterName         types.String `tfsdk:"cluster_name"`
+	ClusterType         types.String `tfsdk:"cluster_type"`
 	Name                types.String `tfsdk:"name"`
 	UUID                types.String `tfsdk:"uuid"`
 	Filename            types.String `tfsdk:"filename"`
@@ -119,7 +125,13 @@ func (d *logCollectorDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	collectors, err := d.client.GetLogCollectors(data.ClusterName.ValueString())
+	clusterType := data.ClusterType.ValueString()
+	if clusterType == "" {
+		clusterType = "kafka"
+	}
+	data.ClusterType = types.StringValue(clusterType)
+
+	collectors, err := d.client.GetLogCollectors(ctx, clusterType, data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read log collectors: %s", err))
 		return
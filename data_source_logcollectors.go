@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*logCollectorsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*logCollectorsDataSource)(nil)
+
+type logCollectorsDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewLogCollectorsDataSource() datasource.DataSource {
+	return &logCollectorsDataSource{}
+}
+
+func (d *logCollectorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *logCollectorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_logcollectors"
+}
+
+func (d *logCollectorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the log collector configurations of a cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The cluster type (kafka, cassandra, or dse). Default: kafka",
+			},
+			"supported_agent_type_filter": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only return collectors that support at least one of these agent types (e.g., broker, schema-registry).",
+			},
+			"collectors": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The log collectors, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the log collector.",
+						},
+						"uuid": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the log collector.",
+						},
+						"filename": schema.StringAttribute{
+							Computed:    true,
+							Description: "The log file path.",
+						},
+						"date_format": schema.StringAttribute{
+							Computed:    true,
+							Description: "The date format used in log entries.",
+						},
+						"info_regex": schema.StringAttribute{
+							Computed:    true,
+							Description: "Regex pattern for INFO level log entries.",
+						},
+						"warning_regex": schema.StringAttribute{
+							Computed:    true,
+							Description: "Regex pattern for WARNING level log entries.",
+						},
+						"error_regex": schema.StringAttribute{
+							Computed:    true,
+							Description: "Regex pattern for ERROR level log entries.",
+						},
+						"debug_regex": schema.StringAttribute{
+							Computed:    true,
+							Description: "Regex pattern for DEBUG level log entries.",
+						},
+						"supported_agent_types": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "List of agent types this collector supports.",
+						},
+						"error_alert_threshold": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Threshold for error alerts.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type logCollectorsDataSourceData struct {
+	ClusterName              types.String        `tfsdk:"cluster_name"`
+	ClusterType              types.String        `tfsdk:"cluster_type"`
+	SupportedAgentTypeFilter []types.String      `tfsdk:"supported_agent_type_filter"`
+	Collectors               []logCollectorEntry `tfsdk:"collectors"`
+}
+
+type logCollectorEntry struct {
+	Name                types.String   `tfsdk:"name"`
+	UUID                types.String   `tfsdk:"uuid"`
+	Filename            types.String   `tfsdk:"filename"`
+	DateFormat          types.String   `tfsdk:"date_format"`
+	InfoRegex           types.String   `tfsdk:"info_regex"`
+	WarningRegex        types.String   `tfsdk:"warning_regex"`
+	ErrorRegex          types.String   `tfsdk:"error_regex"`
+	DebugRegex          types.String   `tfsdk:"debug_regex"`
+	SupportedAgentTypes []types.String `tfsdk:"supported_agent_types"`
+	ErrorAlertThreshold types.Int64    `tfsdk:"error_alert_threshold"`
+}
+
+func (d *logCollectorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data logCollectorsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterType := data.ClusterType.ValueString()
+	if clusterType == "" {
+		clusterType = "kafka"
+	}
+	data.ClusterType = types.StringValue(clusterType)
+
+	collectors, err := d.client.GetLogCollectors(ctx, clusterType, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read log collectors: %s", err))
+		return
+	}
+
+	agentTypes := make(map[string]bool)
+	for _, agentType := range data.SupportedAgentTypeFilter {
+		agentTypes[agentType.ValueString()] = true
+	}
+
+	entries := []logCollectorEntry{}
+	for _, c := range collectors {
+		if data.SupportedAgentTypeFilter != nil && !supportsAnyAgentType(c, agentTypes) {
+			continue
+		}
+
+		entries = append(entries, logCollectorEntry{
+			Name:                types.StringValue(c.Name),
+			UUID:                types.StringValue(c.UUID),
+			Filename:            types.StringValue(c.Filename),
+			DateFormat:          types.StringValue(c.DateFormat),
+			InfoRegex:           types.StringValue(c.InfoRegex),
+			WarningRegex:        types.StringValue(c.WarningRegex),
+			ErrorRegex:          types.StringValue(c.ErrorRegex),
+			DebugRegex:          types.StringValue(c.DebugRegex),
+			SupportedAgentTypes: stringValues(c.SupportedAgentType),
+			ErrorAlertThreshold: types.Int64Value(int64(c.ErrorAlertThreshold)),
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name.ValueString() < entries[j].Name.ValueString()
+	})
+	data.Collectors = entries
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// supportsAnyAgentType reports whether the collector supports one of the agent types
+func supportsAnyAgentType(collector axonopsClient.LogCollectorConfig, agentTypes map[string]bool) bool {
+	for _, agentType := range collector.SupportedAgentType {
+		if agentTypes[agentType] {
+			return true
+		}
+	}
+	return false
+}
@@ -87,6 +87,10 @@ func (d *metricAlertRuleDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:    true,
 				Description: "Description of the alert rule.",
 			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the alert rule is evaluated.",
+			},
 			"dc": schema.ListAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
@@ -142,6 +146,7 @@ type metricAlertRuleDataSourceData struct {
 	CriticalValue types.Float64 `tfsdk:"critical_value"`
 	Duration      types.String  `tfsdk:"duration"`
 	Description   types.String  `tfsdk:"description"`
+	Enabled       types.Bool    `tfsdk:"enabled"`
 	Dc            types.List    `tfsdk:"dc"`
 	Rack          types.List    `tfsdk:"rack"`
 	HostId        types.List    `tfsdk:"host_id"`
@@ -161,7 +166,7 @@ func (d *metricAlertRuleDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	rules, err := d.client.GetAlertRules(data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	rules, err := d.client.GetAlertRules(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert rules: %s", err))
 		return
@@ -187,6 +192,7 @@ func (d *metricAlertRuleDataSource) Read(ctx context.Context, req datasource.Rea
 	data.CriticalValue = types.Float64Value(found.CriticalValue)
 	data.Duration = types.StringValue(found.For)
 	data.Description = types.StringValue(found.Annotations.Description)
+	data.Enabled = types.BoolValue(found.IsEnabled())
 
 	// Parse filters
 	filterMap := map[string]*types.List{
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*metricAlertRulesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*metricAlertRulesDataSource)(nil)
+
+type metricAlertRulesDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewMetricAlertRulesDataSource() datasource.DataSource {
+	return &metricAlertRulesDataSource{}
+}
+
+func (d *metricAlertRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *metricAlertRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_alert_rules"
+}
+
+func (d *metricAlertRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the metric alert rules of a cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+			},
+			"name_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return rules whose name contains this string.",
+			},
+			"operator_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return rules using this comparison operator (e.g., >, <=).",
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The alert rules.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique identifier for the alert rule.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the alert rule.",
+						},
+						"metric": schema.StringAttribute{
+							Computed:    true,
+							Description: "The PromQL-style metric expression.",
+						},
+						"operator": schema.StringAttribute{
+							Computed:    true,
+							Description: "Comparison operator.",
+						},
+						"warning_value": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Warning threshold value.",
+						},
+						"critical_value": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Critical threshold value.",
+						},
+						"duration": schema.StringAttribute{
+							Computed:    true,
+							Description: "Duration before triggering.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description of the alert rule.",
+						},
+						"summary": schema.StringAttribute{
+							Computed:    true,
+							Description: "Summary template of the alert.",
+						},
+						"widget_title": schema.StringAttribute{
+							Computed:    true,
+							Description: "Title of the dashboard widget the rule was created from.",
+						},
+						"correlation_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Correlation ID linking the rule to its dashboard widget.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the alert rule is evaluated.",
+						},
+						"filters": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The filters applied to the metric.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:    true,
+										Description: "The filter name (e.g., dc, rack, groupBy).",
+									},
+									"value": schema.ListAttribute{
+										ElementType: types.StringType,
+										Computed:    true,
+										Description: "The filter values.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type metricAlertRulesDataSourceData struct {
+	ClusterName    types.String           `tfsdk:"cluster_name"`
+	ClusterType    types.String           `tfsdk:"cluster_type"`
+	NameFilter     types.String           `tfsdk:"name_filter"`
+	OperatorFilter types.String           `tfsdk:"operator_filter"`
+	Rules          []metricAlertRuleEntry `tfsdk:"rules"`
+}
+
+type metricAlertRuleEntry struct {
+	ID            types.String             `tfsdk:"id"`
+	Name          types.String             `tfsdk:"name"`
+	Metric        types.String             `tfsdk:"metric"`
+	Operator      types.String             `tfsdk:"operator"`
+	WarningValue  types.Float64            `tfsdk:"warning_value"`
+	CriticalValue types.Float64            `tfsdk:"critical_value"`
+	Duration      types.String             `tfsdk:"duration"`
+	Description   types.String             `tfsdk:"description"`
+	Summary       types.String             `tfsdk:"summary"`
+	WidgetTitle   types.String             `tfsdk:"widget_title"`
+	CorrelationId types.String             `tfsdk:"correlation_id"`
+	Enabled       types.Bool               `tfsdk:"enabled"`
+	Filters       []metricAlertFilterEntry `tfsdk:"filters"`
+}
+
+type metricAlertFilterEntry struct {
+	Name  types.String   `tfsdk:"name"`
+	Value []types.String `tfsdk:"value"`
+}
+
+func (d *metricAlertRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data metricAlertRulesDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := d.client.GetAlertRules(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert rules: %s", err))
+		return
+	}
+
+	entries := []metricAlertRuleEntry{}
+	for _, rule := range rules {
+		if !strings.Contains(rule.Alert, data.NameFilter.ValueString()) {
+			continue
+		}
+		if !data.OperatorFilter.IsNull() && rule.Operator != data.OperatorFilter.ValueString() {
+			continue
+		}
+
+		filters := []metricAlertFilterEntry{}
+		for _, filter := range rule.Filters {
+			filters = append(filters, metricAlertFilterEntry{
+				Name:  types.StringValue(filter.Name),
+				Value: stringValues(filter.Value),
+			})
+		}
+
+		entries = append(entries, metricAlertRuleEntry{
+			ID:            types.StringValue(rule.ID),
+			Name:          types.StringValue(rule.Alert),
+			Metric:        types.StringValue(rule.Expr),
+			Operator:      types.StringValue(rule.Operator),
+			WarningValue:  types.Float64Value(rule.WarningValue),
+			CriticalValue: types.Float64Value(rule.CriticalValue),
+			Duration:      types.StringValue(rule.For),
+			Description:   types.StringValue(rule.Annotations.Description),
+			Summary:       types.StringValue(rule.Annotations.Summary),
+			WidgetTitle:   types.StringValue(rule.WidgetTitle),
+			CorrelationId: types.StringValue(rule.CorrelationId),
+			Enabled:       types.BoolValue(rule.IsEnabled()),
+			Filters:       filters,
+		})
+	}
+	data.Rules = entries
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
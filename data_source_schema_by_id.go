@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*schemaByIDDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*schemaByIDDataSource)(nil)
+
+type schemaByIDDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewSchemaByIDDataSource() datasource.DataSource {
+	return &schemaByIDDataSource{}
+}
+
+func (d *schemaByIDDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *schemaByIDDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_by_id"
+}
+
+func (d *schemaByIDDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a Schema Registry schema by its global ID, as embedded in messages by producers.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"schema_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The global ID of the schema.",
+			},
+			"subject": schema.StringAttribute{
+				Computed:    true,
+				Description: "The first subject the schema is registered under.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The version of the schema in subject.",
+			},
+			"schema": schema.StringAttribute{
+				Computed:    true,
+				Description: "The schema definition.",
+			},
+			"schema_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The schema type (AVRO, PROTOBUF, JSON).",
+			},
+			"references": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Other subjects the schema depends on.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name used to refer to the schema.",
+						},
+						"subject": schema.StringAttribute{
+							Computed:    true,
+							Description: "The subject the referenced schema is registered under.",
+						},
+						"version": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The version of the referenced schema.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type schemaByIDDataSourceData struct {
+	ClusterName types.String           `tfsdk:"cluster_name"`
+	SchemaId    types.Int64            `tfsdk:"schema_id"`
+	Subject     types.String           `tfsdk:"subject"`
+	Version     types.Int64            `tfsdk:"version"`
+	Schema      types.String           `tfsdk:"schema"`
+	SchemaType  types.String           `tfsdk:"schema_type"`
+	References  []schemaReferenceEntry `tfsdk:"references"`
+}
+
+type schemaReferenceEntry struct {
+	Name    types.String `tfsdk:"name"`
+	Subject types.String `tfsdk:"subject"`
+	Version types.Int64  `tfsdk:"version"`
+}
+
+func (d *schemaByIDDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemaByIDDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := data.ClusterName.ValueString()
+	id := int(data.SchemaId.ValueInt64())
+
+	result, err := d.client.GetSchemaByID(ctx, clusterName, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema: %s", err))
+		return
+	}
+
+	if result == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Schema ID %d not found", id))
+		return
+	}
+
+	// The schema itself doesn't say which subjects use it
+	versions, err := d.client.GetSchemaIDVersions(ctx, clusterName, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema versions: %s", err))
+		return
+	}
+
+	data.Subject = types.StringValue("")
+	data.Version = types.Int64Value(0)
+	if len(versions) > 0 {
+		data.Subject = types.StringValue(versions[0].Subject)
+		data.Version = types.Int64Value(int64(versions[0].Version))
+	}
+
+	references := []schemaReferenceEntry{}
+	for _, ref := range result.References {
+		references = append(references, schemaReferenceEntry{
+			Name:    types.StringValue(ref.Name),
+			Subject: types.StringValue(ref.Subject),
+			Version: types.Int64Value(int64(ref.Version)),
+		})
+	}
+
+	data.Schema = types.StringValue(result.Schema)
+	data.SchemaType = types.StringValue(result.SchemaType)
+	data.References = references
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*schemaRegistryConfigDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*schemaRegistryConfigDataSource)(nil)
+
+type schemaRegistryConfigDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewSchemaRegistryConfigDataSource() datasource.DataSource {
+	return &schemaRegistryConfigDataSource{}
+}
+
+func (d *schemaRegistryConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *schemaRegistryConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_registry_config"
+}
+
+func (d *schemaRegistryConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the global Schema Registry settings of a Kafka cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"default_compatibility_level": schema.StringAttribute{
+				Computed:    true,
+				Description: "The compatibility level of subjects without an override.",
+			},
+			"mode": schema.StringAttribute{
+				Computed:    true,
+				Description: "The registry mode (IMPORT, READONLY, READWRITE).",
+			},
+		},
+	}
+}
+
+type schemaRegistryConfigDataSourceData struct {
+	ClusterName               types.String `tfsdk:"cluster_name"`
+	DefaultCompatibilityLevel types.String `tfsdk:"default_compatibility_level"`
+	Mode                      types.String `tfsdk:"mode"`
+}
+
+func (d *schemaRegistryConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemaRegistryConfigDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := d.client.GetSchemaRegistryConfig(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema registry config: %s", err))
+		return
+	}
+
+	data.DefaultCompatibilityLevel = types.StringValue(config.CompatibilityLevel)
+	data.Mode = types.StringValue(config.Mode)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
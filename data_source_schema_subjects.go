@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*schemaSubjectsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*schemaSubjectsDataSource)(nil)
+
+type schemaSubjectsDataSource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewSchemaSubjectsDataSource() datasource.DataSource {
+	return &schemaSubjectsDataSource{}
+}
+
+func (d *schemaSubjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *schemaSubjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_subjects"
+}
+
+func (d *schemaSubjectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the subjects registered in the Schema Registry of a Kafka cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"subject_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return subjects matching this regular expression (e.g., -value$).",
+			},
+			"subjects": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The subject names, sorted alphabetically.",
+			},
+		},
+	}
+}
+
+type schemaSubjectsDataSourceData struct {
+	ClusterName   types.String   `tfsdk:"cluster_name"`
+	SubjectFilter types.String   `tfsdk:"subject_filter"`
+	Subjects      []types.String `tfsdk:"subjects"`
+}
+
+func (d *schemaSubjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schemaSubjectsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := regexp.Compile(data.SubjectFilter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subject_filter"),
+			"Invalid Subject Filter",
+			fmt.Sprintf("subject_filter must be a valid regular expression: %s", err),
+		)
+		return
+	}
+
+	subjects, err := d.client.GetSchemaSubjects(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema subjects: %s", err))
+		return
+	}
+
+	var matched []string
+	for _, subject := range subjects {
+		if filter.MatchString(subject) {
+			matched = append(matched, subject)
+		}
+	}
+	sort.Strings(matched)
+
+	data.Subjects = stringValues(matched)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
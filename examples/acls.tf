@@ -83,3 +83,60 @@ resource "axonops_kafka_acl" "admin_cluster" {
   operation             = "ALL"
   permission_type       = "ALLOW"
 }
+
+# Manage all ACLs of a principal in a single resource
+resource "axonops_kafka_acl_batch" "orders_service" {
+  cluster_name = "my-kafka-cluster"
+  principal    = "User:orders-service"
+
+  rules = [
+    {
+      resource_type   = "TOPIC"
+      resource_name   = "orders"
+      operation       = "WRITE"
+      permission_type = "ALLOW"
+    },
+    {
+      resource_type   = "TOPIC"
+      resource_name   = "orders"
+      operation       = "DESCRIBE"
+      permission_type = "ALLOW"
+    },
+    {
+      resource_type         = "GROUP"
+      resource_name         = "orders-"
+      resource_pattern_type = "PREFIXED"
+      operation             = "READ"
+      permission_type       = "ALLOW"
+    },
+  ]
+}
+
+# Audit the cluster for ACLs allowing every operation on all topics
+data "axonops_kafka_acls" "allowed" {
+  cluster_name           = "my-kafka-cluster"
+  resource_type_filter   = "TOPIC"
+  permission_type_filter = "ALLOW"
+}
+
+check "no_allow_all_topics" {
+  assert {
+    condition = alltrue([
+      for r in data.axonops_kafka_acls.allowed.resources : alltrue([
+        for a in r.acls : !(r.resource_name == "*" && a.operation == "ALL")
+      ])
+    ])
+    error_message = "An ACL allows ALL operations on every topic."
+  }
+}
+
+# A topic with the standard ACLs for one producer and one consumer
+resource "axonops_kafka_topic_acl" "payments" {
+  cluster_name       = "my-kafka-cluster"
+  topic_name         = "payments"
+  partitions         = 6
+  replication_factor = 3
+  producer_principal = "User:payments-service"
+  consumer_principal = "User:ledger-service"
+  consumer_group     = "ledger"
+}
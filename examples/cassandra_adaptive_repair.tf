@@ -65,7 +65,61 @@ resource "axonops_cassandra_adaptive_repair" "production" {
   ]
 }
 
+# Less aggressive repair during business hours
+resource "axonops_cassandra_adaptive_repair" "business_hours" {
+  cluster_name = "production-cassandra"
+  active       = true
+  parallelism  = 10
+
+  schedule_overrides = [
+    {
+      hour_start      = 8
+      hour_end        = 18
+      parallelism     = 2
+      segment_retries = 1
+    },
+  ]
+}
+
 # Read existing adaptive repair settings
 data "axonops_cassandra_adaptive_repair" "existing" {
   cluster_name = "my-cassandra-cluster"
 }
+
+# Check whether a repair is currently running
+data "axonops_cassandra_adaptive_repair_status" "current" {
+  cluster_name = "my-cassandra-cluster"
+}
+
+output "repair_running" {
+  value = data.axonops_cassandra_adaptive_repair_status.current.is_running
+}
+
+# Restart the nodes of a datacenter one at a time; bump config_version to restart again
+resource "axonops_cassandra_rolling_restart" "dc1" {
+  cluster_name              = "my-cassandra-cluster"
+  datacenter                = "dc1"
+  wait_for_schema_agreement = true
+  inter_node_delay          = "2m"
+
+  triggers = {
+    config_version = "1"
+  }
+}
+
+output "rolling_restart_status" {
+  value = axonops_cassandra_rolling_restart.dc1.status
+}
+
+# Recent repair runs, e.g. to check the last repair succeeded
+data "axonops_cassandra_repair_history" "recent" {
+  cluster_name = "my-cassandra-cluster"
+  limit        = 10
+}
+
+check "last_repair_succeeded" {
+  assert {
+    condition     = length(data.axonops_cassandra_repair_history.recent.history) > 0 && data.axonops_cassandra_repair_history.recent.history[0].status == "success"
+    error_message = "The last repair of my-cassandra-cluster did not succeed."
+  }
+}
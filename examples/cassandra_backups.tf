@@ -94,3 +94,26 @@ data "axonops_cassandra_backup" "existing" {
   cluster_name = "my-cassandra-cluster"
   tag          = "daily-backup"
 }
+
+# On-demand snapshot, e.g. before a schema migration. A new snapshot is taken
+# whenever a trigger value changes.
+resource "axonops_cassandra_snapshot" "pre_migration" {
+  cluster_name = "my-cassandra-cluster"
+  tag          = "pre-migration"
+  datacenters  = ["dc1"]
+  keyspaces    = ["my_keyspace"]
+
+  triggers = {
+    migration_version = "2024-06-01"
+  }
+}
+
+# List the daily backups in the catalog
+data "axonops_cassandra_backup_history" "daily" {
+  cluster_name = "my-cassandra-cluster"
+  tag_filter   = "daily"
+}
+
+output "daily_backup_schedules" {
+  value = [for b in data.axonops_cassandra_backup_history.daily.backups : "${b.tag}: ${b.schedule_expr}"]
+}
@@ -0,0 +1,35 @@
+# Cassandra Keyspace Examples
+
+# Keyspace replicated across two datacenters
+resource "axonops_cassandra_keyspace" "orders" {
+  cluster_name         = "my-cassandra-cluster"
+  keyspace_name        = "orders"
+  replication_strategy = "NetworkTopologyStrategy"
+  replication_options = {
+    dc1 = "3"
+    dc2 = "3"
+  }
+}
+
+# Single datacenter keyspace for development
+resource "axonops_cassandra_keyspace" "scratch" {
+  cluster_name         = "my-dev-cluster"
+  keyspace_name        = "scratch"
+  replication_strategy = "SimpleStrategy"
+  replication_options = {
+    replication_factor = "1"
+  }
+}
+
+# Check the current workload of a DSE node before changing it
+data "axonops_dse_workload" "node1" {
+  cluster_name = "my-dse-cluster"
+  node_id      = "node-1"
+}
+
+# Run Search on a DSE node
+resource "axonops_dse_workload" "node1" {
+  cluster_name  = "my-dse-cluster"
+  node_id       = "node-1"
+  workload_type = "Search"
+}
@@ -18,11 +18,9 @@ resource "axonops_kafka_topic" "user_events" {
   replication_factor = 3
   cluster_name       = local.cluster_name
 
-  config = {
-    cleanup_policy      = "delete"
-    retention_ms        = "604800000"  # 7 days
-    min_insync_replicas = "2"
-  }
+  cleanup_policy      = "delete"
+  retention_ms        = 604800000 # 7 days
+  min_insync_replicas = 2
 }
 
 # Order events topic
@@ -32,11 +30,9 @@ resource "axonops_kafka_topic" "orders" {
   replication_factor = 3
   cluster_name       = local.cluster_name
 
-  config = {
-    cleanup_policy      = "delete"
-    retention_ms        = "2592000000"  # 30 days
-    min_insync_replicas = "2"
-  }
+  cleanup_policy      = "delete"
+  retention_ms        = 2592000000 # 30 days
+  min_insync_replicas = 2
 }
 
 # User profiles compacted topic
@@ -46,10 +42,8 @@ resource "axonops_kafka_topic" "user_profiles" {
   replication_factor = 3
   cluster_name       = local.cluster_name
 
-  config = {
-    cleanup_policy            = "compact"
-    min_cleanable_dirty_ratio = "0.1"
-  }
+  cleanup_policy            = "compact"
+  min_cleanable_dirty_ratio = 0.1
 }
 
 # Dead letter queue
@@ -59,10 +53,8 @@ resource "axonops_kafka_topic" "dlq" {
   replication_factor = 3
   cluster_name       = local.cluster_name
 
-  config = {
-    cleanup_policy = "delete"
-    retention_ms   = "2592000000"  # 30 days
-  }
+  cleanup_policy = "delete"
+  retention_ms   = 2592000000 # 30 days
 }
 
 # =============================================================================
@@ -108,3 +108,67 @@ resource "axonops_kafka_connect_connector" "debezium_mysql" {
     "schema.history.internal.kafka.topic"             = "schema-changes.inventory"
   }
 }
+
+# Connector paused for maintenance; set paused = false to resume it
+resource "axonops_kafka_connect_connector" "paused_sink" {
+  cluster_name         = "my-kafka-cluster"
+  connect_cluster_name = "my-connect-cluster"
+  name                 = "archive-sink"
+  paused               = true
+
+  config = {
+    "connector.class" = "org.apache.kafka.connect.file.FileStreamSinkConnector"
+    "tasks.max"       = "1"
+    "file"            = "/tmp/archive.txt"
+    "topics"          = "my-topic"
+  }
+}
+
+# Fail the plan when the CDC connector or any of its tasks isn't running
+data "axonops_connector_status" "debezium_mysql" {
+  cluster_name         = axonops_kafka_connect_connector.debezium_mysql.cluster_name
+  connect_cluster_name = axonops_kafka_connect_connector.debezium_mysql.connect_cluster_name
+  name                 = axonops_kafka_connect_connector.debezium_mysql.name
+}
+
+check "debezium_mysql_running" {
+  assert {
+    condition = data.axonops_connector_status.debezium_mysql.state == "RUNNING" && alltrue([
+      for task in data.axonops_connector_status.debezium_mysql.tasks : task.state == "RUNNING"
+    ])
+    error_message = "The mysql-cdc connector or one of its tasks is not running."
+  }
+}
+
+# Deploy a heartbeat connector to every Connect cluster
+data "axonops_kafka_connect_clusters" "all" {
+  cluster_name = "my-kafka-cluster"
+}
+
+resource "axonops_kafka_connect_connector" "heartbeat" {
+  for_each = toset(data.axonops_kafka_connect_clusters.all.connect_clusters)
+
+  cluster_name         = "my-kafka-cluster"
+  connect_cluster_name = each.value
+  name                 = "heartbeat-${each.value}"
+
+  config = {
+    "connector.class" = "org.apache.kafka.connect.mirror.MirrorHeartbeatConnector"
+    "tasks.max"       = "1"
+  }
+}
+
+# Fail the plan when a connector expected on the Connect clusters is missing
+data "axonops_kafka_connect_connectors" "all" {
+  cluster_name = "my-kafka-cluster"
+}
+
+check "expected_connectors_present" {
+  assert {
+    condition = length(setsubtract(
+      ["mysql-cdc", "file-sink-connector"],
+      [for c in data.axonops_kafka_connect_connectors.all.connectors : c.name],
+    )) == 0
+    error_message = "One or more expected connectors are missing from the Connect clusters."
+  }
+}
@@ -10,6 +10,13 @@ resource "axonops_healthcheck_tcp" "kafka_broker" {
   interval              = "30s"
   timeout               = "10s"
   supported_agent_types = ["broker", "kraft-broker"]
+
+  # Page on-call when a broker port stops responding
+  integrations = {
+    type           = "pagerduty"
+    routing        = [axonops_integration_definition.pagerduty.id]
+    override_error = true
+  }
 }
 
 # Check Kafka controller port
@@ -78,6 +85,17 @@ resource "axonops_healthcheck_http" "connect_health" {
   supported_agent_types = ["all"]
 }
 
+# Check an internal HTTPS endpoint that uses a self-signed certificate
+resource "axonops_healthcheck_http" "connect_internal_tls" {
+  cluster_name          = "my-kafka-cluster"
+  name                  = "Kafka Connect Internal TLS"
+  url                   = "https://localhost:8443/connectors"
+  method                = "GET"
+  expected_status       = 200
+  tls_skip_verify       = true
+  supported_agent_types = ["all"]
+}
+
 # Check custom application health with headers
 resource "axonops_healthcheck_http" "app_health" {
   cluster_name          = "my-kafka-cluster"
@@ -131,6 +149,21 @@ resource "axonops_healthcheck_shell" "kafka_process" {
   timeout      = "10s"
 }
 
+# Check a topic can be produced to, with the client credentials passed to the script
+resource "axonops_healthcheck_shell" "produce_check" {
+  cluster_name = "my-kafka-cluster"
+  name         = "Produce Check"
+  script       = "/usr/local/bin/check_produce.sh"
+  shell        = "/bin/bash"
+  interval     = "5m"
+  timeout      = "1m"
+
+  env = {
+    KAFKA_SASL_USERNAME = "healthcheck"
+    KAFKA_SASL_PASSWORD = var.healthcheck_sasl_password
+  }
+}
+
 # Check JVM heap usage
 resource "axonops_healthcheck_shell" "jvm_heap" {
   cluster_name = "my-kafka-cluster"
@@ -168,3 +201,31 @@ resource "axonops_healthcheck_shell" "custom_monitor" {
   interval     = "3m"
   timeout      = "1m"
 }
+
+# Cassandra Healthchecks
+
+# Check the CQL native transport port
+resource "axonops_healthcheck_tcp" "cassandra_cql" {
+  cluster_name = "my-cassandra-cluster"
+  cluster_type = "cassandra"
+  name         = "CQL Port"
+  tcp          = "0.0.0.0:9042"
+  interval     = "30s"
+  timeout      = "10s"
+}
+
+# Check nodetool reports the node as up
+resource "axonops_healthcheck_shell" "cassandra_status" {
+  cluster_name = "my-cassandra-cluster"
+  cluster_type = "cassandra"
+  name         = "Nodetool Status"
+  script       = "nodetool status | grep -q \"^UN\""
+  shell        = "/bin/bash"
+  interval     = "5m"
+  timeout      = "1m"
+}
+
+variable "healthcheck_sasl_password" {
+  type      = string
+  sensitive = true
+}
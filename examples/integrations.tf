@@ -0,0 +1,144 @@
+# Integration Examples
+
+# Slack integration; the webhook URL is kept out of plan output
+resource "axonops_integration_definition" "ops_slack" {
+  cluster_name = "my-kafka-cluster"
+  cluster_type = "kafka"
+  type         = "slack"
+
+  params = {
+    name    = "ops-alerts"
+    channel = "#ops-alerts"
+  }
+
+  sensitive_params = {
+    url = var.slack_webhook_url
+  }
+}
+
+# PagerDuty integration
+resource "axonops_integration_definition" "pagerduty" {
+  cluster_name = "my-kafka-cluster"
+  cluster_type = "kafka"
+  type         = "pagerduty"
+
+  params = {
+    name = "on-call"
+  }
+
+  sensitive_params = {
+    routing_key = var.pagerduty_routing_key
+  }
+}
+
+# Webhook to an internal incident service, with a token header kept out of plan output
+resource "axonops_integration_webhook" "incidents" {
+  cluster_name = "my-kafka-cluster"
+  cluster_type = "kafka"
+  name         = "incident-service"
+  url          = "https://incidents.example.com/hooks/axonops"
+
+  headers = {
+    Authorization = "Bearer ${var.incident_service_token}"
+  }
+}
+
+# Slack integration with typed settings, paging the on-call group
+resource "axonops_integration_slack" "kafka_oncall" {
+  cluster_name   = "my-kafka-cluster"
+  cluster_type   = "kafka"
+  name           = "kafka-oncall"
+  webhook_url    = var.slack_webhook_url
+  channel        = "#kafka-oncall"
+  username       = "AxonOps"
+  icon_emoji     = ":rotating_light:"
+  mention_groups = ["S0614TZR7"]
+}
+
+# PagerDuty integration with typed settings, paging errors as critical incidents
+resource "axonops_integration_pagerduty" "kafka_oncall" {
+  cluster_name = "my-kafka-cluster"
+  cluster_type = "kafka"
+  name         = "kafka-pagerduty"
+  routing_key  = var.pagerduty_routing_key
+  source       = "axonops-kafka-prod"
+
+  severity_mapping = {
+    warning = "warning"
+    error   = "critical"
+  }
+}
+
+# Email integration through the company SMTP relay
+resource "axonops_integration_email" "dba_team" {
+  cluster_name  = "my-kafka-cluster"
+  cluster_type  = "kafka"
+  name          = "dba-team-email"
+  smtp_host     = "smtp.example.com"
+  smtp_username = "axonops"
+  smtp_password = var.smtp_password
+  from_address  = "axonops@example.com"
+  to_addresses  = ["dba-team@example.com", "oncall@example.com"]
+}
+
+# Route error alerts to the Slack integration by its name
+resource "axonops_alert_route" "errors_to_slack" {
+  cluster_name     = axonops_integration_definition.ops_slack.cluster_name
+  cluster_type     = axonops_integration_definition.ops_slack.cluster_type
+  integration_type = axonops_integration_definition.ops_slack.type
+  integration_name = axonops_integration_definition.ops_slack.params["name"]
+  type             = "global"
+  severity         = "error"
+}
+
+# Look up the ID of an integration managed outside of Terraform
+data "axonops_integrations" "all" {
+  cluster_name = "my-kafka-cluster"
+  cluster_type = "kafka"
+}
+
+output "oncall_slack_id" {
+  value = one([for d in data.axonops_integrations.all.definitions : d.id if d.name == "oncall-slack"])
+}
+
+# Audit where error alerts are sent without managing the routes
+data "axonops_alert_route" "global_errors" {
+  cluster_name = "my-kafka-cluster"
+  cluster_type = "kafka"
+  type         = "global"
+  severity     = "error"
+}
+
+output "global_error_integrations" {
+  value = [for r in data.axonops_alert_route.global_errors.routes : "${r.integration_type}/${r.integration_name}"]
+}
+
+# Every route of the cluster, for all route types and severities
+data "axonops_alert_routes" "all" {
+  cluster_name = "my-kafka-cluster"
+  cluster_type = "kafka"
+}
+
+output "routed_route_types" {
+  value = [for r in data.axonops_alert_routes.all.routes : r.type if length(r.routing) > 0]
+}
+
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+variable "incident_service_token" {
+  type      = string
+  sensitive = true
+}
+
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "pagerduty_routing_key" {
+  type      = string
+  sensitive = true
+}
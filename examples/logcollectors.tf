@@ -67,3 +67,41 @@ resource "axonops_logcollector" "gc_log" {
   date_format           = "yyyy-MM-ddTHH:mm:ss"
   supported_agent_types = ["broker", "kraft-broker", "kraft-controller"]
 }
+
+# Server log collector with archiving to S3
+resource "axonops_logcollector" "archived_server_log" {
+  cluster_name          = "my-kafka-cluster"
+  name                  = "Archived Kafka Server Log"
+  filename              = "{{index . \"comp_jvm_kafka.logs.dir\"}}/server.log"
+  supported_agent_types = ["broker", "kraft-broker"]
+
+  archive = {
+    enabled       = true
+    remote_type   = "s3"
+    remote_path   = "my-log-archive/kafka"
+    archive_after = "24h"
+  }
+}
+
+# Check that every broker has a server log collector
+data "axonops_logcollectors" "broker" {
+  cluster_name                = "my-kafka-cluster"
+  supported_agent_type_filter = ["broker", "kraft-broker"]
+}
+
+check "broker_server_log_collected" {
+  assert {
+    condition     = anytrue([for c in data.axonops_logcollectors.broker.collectors : endswith(c.filename, "/server.log")])
+    error_message = "No log collector reads server.log on the brokers."
+  }
+}
+
+# Cassandra system log collector
+resource "axonops_logcollector" "cassandra_system_log" {
+  cluster_name          = "my-cassandra-cluster"
+  cluster_type          = "cassandra"
+  name                  = "Cassandra System Log"
+  filename              = "/var/log/cassandra/system.log"
+  date_format           = "yyyy-MM-dd HH:mm:ss,SSS"
+  supported_agent_types = ["all"]
+}
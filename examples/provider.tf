@@ -16,4 +16,18 @@ provider "axonops" {
 
   # Token type for Authorization header: 'Bearer' (default) or 'AxonApi'
   token_type = "Bearer"
+
+  # Timeout in seconds for each API request (default 30). Raise it for large
+  # clusters where backup or bulk configuration calls take longer.
+  http_timeout = 60
+
+  # Route API requests through a corporate proxy (defaults to HTTPS_PROXY / HTTP_PROXY)
+  # http_proxy          = "http://proxy.example.com:3128"
+  # http_proxy_username = "proxy-user"
+  # http_proxy_password = var.proxy_password
+
+  # Mutual TLS for on-premises installations that require client certificates
+  # tls_client_cert = "/etc/axonops/client.pem"
+  # tls_client_key  = "/etc/axonops/client-key.pem"
+  # tls_ca_cert     = "/etc/axonops/ca.pem"
 }
@@ -0,0 +1,26 @@
+# Kafka Client Quota Examples
+
+# Limit the throughput of a user
+resource "axonops_kafka_quota" "analytics_user" {
+  cluster_name       = "my-kafka-cluster"
+  entity_type        = "user"
+  entity_name        = "analytics"
+  producer_byte_rate = 1048576
+  consumer_byte_rate = 2097152
+}
+
+# Cap the request handler time of a client-id
+resource "axonops_kafka_quota" "batch_client" {
+  cluster_name       = "my-kafka-cluster"
+  entity_type        = "client-id"
+  entity_name        = "nightly-batch"
+  request_percentage = 25
+}
+
+# Quota for a specific client-id of a user
+resource "axonops_kafka_quota" "orders_ingest" {
+  cluster_name       = "my-kafka-cluster"
+  entity_type        = "user+client-id"
+  entity_name        = "orders-service/ingest"
+  producer_byte_rate = 5242880
+}
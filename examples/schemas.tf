@@ -159,4 +159,89 @@ resource "axonops_schema" "user_events_key" {
       }
     ]
   })
+
+  # Purge all versions of the subject on destroy
+  hard_delete = true
+}
+
+# Global compatibility level for the Schema Registry
+resource "axonops_schema_compatibility" "global" {
+  cluster_name        = "my-kafka-cluster"
+  compatibility_level = "BACKWARD"
+}
+
+# Per-subject override; removing it falls back to the global level
+resource "axonops_schema_compatibility" "user_events_key" {
+  cluster_name        = "my-kafka-cluster"
+  subject             = axonops_schema.user_events_key.subject
+  compatibility_level = "FULL_TRANSITIVE"
+}
+
+# Freeze a subject so no new versions can be registered
+resource "axonops_schema_subject_mode" "user_events_key" {
+  cluster_name = "my-kafka-cluster"
+  subject      = axonops_schema.user_events_key.subject
+  mode         = "READONLY"
+}
+
+# Name subjects after the record so a topic can hold several event types
+resource "axonops_schema_naming_strategy" "my_cluster" {
+  cluster_name = "my-kafka-cluster"
+  strategy     = "TopicRecordNameStrategy"
+}
+
+# Protobuf schema importing a message from another subject
+resource "axonops_schema" "sensor_batch" {
+  cluster_name = "my-kafka-cluster"
+  subject      = "sensor-batches-value"
+  schema_type  = "PROTOBUF"
+  schema       = <<-EOT
+    syntax = "proto3";
+    package com.example.sensors;
+
+    import "sensor_reading.proto";
+
+    message SensorBatch {
+      repeated SensorReading readings = 1;
+    }
+  EOT
+
+  references = [
+    {
+      name    = "sensor_reading.proto"
+      subject = "sensor-readings-value"
+      version = 1
+    },
+  ]
+}
+
+# List the value subjects and read the latest schema of each
+data "axonops_schema_subjects" "values" {
+  cluster_name   = "my-kafka-cluster"
+  subject_filter = "-value$"
+}
+
+data "axonops_schema" "values" {
+  for_each = toset(data.axonops_schema_subjects.values.subjects)
+
+  cluster_name = "my-kafka-cluster"
+  subject      = each.value
+}
+
+# Look up the schema a consumer saw in a message by its global ID
+data "axonops_schema_by_id" "from_message" {
+  cluster_name = "my-kafka-cluster"
+  schema_id    = 42
+}
+
+# Global compatibility level and mode of the Schema Registry on another cluster.
+# Don't combine with a global axonops_schema_compatibility on the same cluster.
+resource "axonops_schema_registry_config" "replica" {
+  cluster_name                = "my-replica-kafka-cluster"
+  default_compatibility_level = "FULL"
+  mode                        = "READONLY"
+}
+
+data "axonops_schema_registry_config" "primary" {
+  cluster_name = "my-kafka-cluster"
 }
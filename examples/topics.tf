@@ -15,12 +15,13 @@ resource "axonops_kafka_topic" "configured" {
   replication_factor = 3
   cluster_name       = "my-kafka-cluster"
 
-  # Topic configurations (use underscores instead of dots)
+  cleanup_policy      = "compact"
+  retention_ms        = 604800000 # 7 days
+  min_insync_replicas = 2
+
+  # Other topic configurations (use underscores instead of dots)
   config = {
-    cleanup_policy      = "compact"
-    retention_ms        = "604800000"  # 7 days
-    segment_bytes       = "1073741824" # 1GB
-    min_insync_replicas = "2"
+    segment_bytes = "1073741824" # 1GB
   }
 }
 
@@ -31,11 +32,12 @@ resource "axonops_kafka_topic" "events" {
   replication_factor = 3
   cluster_name       = "my-kafka-cluster"
 
+  cleanup_policy      = "delete"
+  retention_ms        = 259200000 # 3 days
+  delete_retention_ms = 86400000  # 1 day
+
   config = {
-    cleanup_policy       = "delete"
-    retention_ms         = "259200000"    # 3 days
-    delete_retention_ms  = "86400000"     # 1 day
-    max_message_bytes    = "1048576"      # 1MB
+    max_message_bytes = "1048576" # 1MB
   }
 }
 
@@ -46,10 +48,123 @@ resource "axonops_kafka_topic" "state_store" {
   replication_factor = 3
   cluster_name       = "my-kafka-cluster"
 
+  cleanup_policy            = "compact"
+  min_cleanable_dirty_ratio = 0.1
+  delete_retention_ms       = 86400000 # 1 day
+
   config = {
-    cleanup_policy               = "compact"
-    min_cleanable_dirty_ratio    = "0.1"
-    segment_ms                   = "3600000"  # 1 hour
-    delete_retention_ms          = "86400000" # 1 day
+    segment_ms = "3600000" # 1 hour
   }
 }
+
+# Cluster-wide topic governance
+resource "axonops_kafka_cluster_policy" "governance" {
+  cluster_name           = "my-kafka-cluster"
+  prevent_topic_deletion = true
+  require_config_keys    = ["retention.ms", "cleanup.policy"]
+  max_partition_count    = 48
+  min_replication_factor = 3
+}
+
+# List every topic in the cluster
+data "axonops_kafka_topics" "all" {
+  cluster_name = "my-kafka-cluster"
+}
+
+output "topic_names" {
+  value = [for t in data.axonops_kafka_topics.all.topics : t.name]
+}
+
+# Read the lag of a consumer group
+data "axonops_consumer_group" "orders_processor" {
+  cluster_name = "my-kafka-cluster"
+  group_id     = "orders-processor"
+}
+
+output "orders_processor_lag" {
+  value = data.axonops_consumer_group.orders_processor.lag["orders"]
+}
+
+# List all consumer groups in the cluster
+data "axonops_consumer_groups" "all" {
+  cluster_name = "my-kafka-cluster"
+}
+
+# Read the explicitly set configs of the first broker
+data "axonops_kafka_broker_config" "default" {
+  cluster_name  = "my-kafka-cluster"
+  explicit_only = true
+}
+
+# Read the brokers of the cluster
+data "axonops_kafka_cluster_info" "my_cluster" {
+  cluster_name = "my-kafka-cluster"
+}
+
+# Fail the plan rather than the topic creation when the cluster is too small
+resource "axonops_kafka_topic" "payments" {
+  name               = "payments"
+  partitions         = 6
+  replication_factor = 3
+  cluster_name       = "my-kafka-cluster"
+
+  lifecycle {
+    precondition {
+      condition     = data.axonops_kafka_cluster_info.my_cluster.broker_count >= 3
+      error_message = "The payments topic needs at least 3 brokers for its replication factor."
+    }
+  }
+}
+
+# Tune the brokers without a rolling restart
+resource "axonops_kafka_broker_config" "my_cluster" {
+  cluster_name = "my-kafka-cluster"
+
+  config = {
+    "log.retention.hours" = "168"
+    "num.io.threads"      = "16"
+    "message.max.bytes"   = "2097152"
+  }
+}
+
+# Retain topic data as long as the broker default
+resource "axonops_kafka_topic" "audit_log" {
+  name               = "audit-log"
+  partitions         = 3
+  replication_factor = 3
+  cluster_name       = "my-kafka-cluster"
+
+  retention_ms = tonumber(lookup(data.axonops_kafka_broker_config.default.config, "log.retention.ms", "604800000"))
+}
+
+# Replay the orders topic from a point in time after an incident.
+# Stop the consumers first; change the incident trigger to reset again.
+resource "axonops_kafka_consumer_group_reset" "orders_processor_replay" {
+  cluster_name = "my-kafka-cluster"
+  group_id     = "orders-processor"
+  topic        = "orders"
+  reset_type   = "to-datetime"
+  reset_value  = "2024-01-31T12:00:00Z"
+
+  triggers = {
+    incident = "INC-1234"
+  }
+}
+
+# Give the orders processor longer sessions
+resource "axonops_kafka_consumer_group_config" "orders_processor" {
+  cluster_name = "my-kafka-cluster"
+  group_id     = "orders-processor"
+
+  config = {
+    "consumer.session.timeout.ms"    = "60000"
+    "consumer.heartbeat.interval.ms" = "10000"
+  }
+}
+
+# Look up the Kafka name of each config key accepted in the config map
+data "axonops_kafka_topic_config_keys" "all" {}
+
+output "topic_config_names" {
+  value = { for c in data.axonops_kafka_topic_config_keys.all.config_keys : c.key => c.name if !c.dedicated_attribute }
+}
@@ -2,14 +2,22 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
 
 	axonopsClient "terraform-provider-axonops/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,8 +34,32 @@ type axonopsProviderModel struct {
 	AxonopsProtocol types.String `tfsdk:"axonops_protocol"`
 	OrgId           types.String `tfsdk:"org_id"`
 	TokenType       types.String `tfsdk:"token_type"`
+	HttpTimeout     types.Int64  `tfsdk:"http_timeout"`
+
+	MaxConnections           types.Int64 `tfsdk:"max_connections"`
+	ConnectionTimeoutSeconds types.Int64 `tfsdk:"connection_timeout_seconds"`
+
+	HttpProxy         types.String `tfsdk:"http_proxy"`
+	HttpProxyUsername types.String `tfsdk:"http_proxy_username"`
+	HttpProxyPassword types.String `tfsdk:"http_proxy_password"`
+
+	TlsClientCert         types.String `tfsdk:"tls_client_cert"`
+	TlsClientKey          types.String `tfsdk:"tls_client_key"`
+	TlsCaCert             types.String `tfsdk:"tls_ca_cert"`
+	TlsInsecureSkipVerify types.Bool   `tfsdk:"tls_insecure_skip_verify"`
+
+	Debug types.Bool `tfsdk:"debug"`
 }
 
+// defaultHttpTimeout is used when http_timeout isn't configured
+const defaultHttpTimeout = 30 * time.Second
+
+// Connection pool defaults, used when max_connections and connection_timeout_seconds aren't configured
+const (
+	defaultMaxConnections           = 10
+	defaultConnectionTimeoutSeconds = 30
+)
+
 func New() func() provider.Provider {
 	return func() provider.Provider {
 		return &axonopsProvider{}
@@ -42,30 +74,60 @@ func (p *axonopsProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	var protocol = "https"
-	var axonopsHost = ""
-	var apiKey = ""
-	var tokenType = "Bearer"
+	// Values set in HCL take precedence over environment variables
+	protocol := configOrEnv(config.AxonopsProtocol, "AXONOPS_PROTOCOL")
+	axonopsHost := configOrEnv(config.AxonopsHost, "AXONOPS_HOST")
+	apiKey := configOrEnv(config.ApiKey, "AXONOPS_API_KEY")
+	orgId := configOrEnv(config.OrgId, "AXONOPS_ORG_ID")
+	tokenType := configOrEnv(config.TokenType, "AXONOPS_TOKEN_TYPE")
 
-	if !config.AxonopsProtocol.IsNull() {
-		protocol = config.AxonopsProtocol.ValueString()
+	// Without http_proxy the client uses HTTPS_PROXY, HTTP_PROXY and NO_PROXY from the environment
+	proxy := axonopsClient.ProxyConfig{
+		URL:      config.HttpProxy.ValueString(),
+		Username: configOrEnv(config.HttpProxyUsername, "AXONOPS_HTTP_PROXY_USERNAME"),
+		Password: configOrEnv(config.HttpProxyPassword, "AXONOPS_HTTP_PROXY_PASSWORD"),
 	}
 
-	if !config.AxonopsHost.IsNull() {
-		axonopsHost = config.AxonopsHost.ValueString()
+	tlsSettings := axonopsClient.TLSConfig{
+		ClientCertFile: configOrEnv(config.TlsClientCert, "AXONOPS_TLS_CLIENT_CERT"),
+		ClientKeyFile:  configOrEnv(config.TlsClientKey, "AXONOPS_TLS_CLIENT_KEY"),
+		CACertFile:     configOrEnv(config.TlsCaCert, "AXONOPS_TLS_CA_CERT"),
+	}
+	if !config.TlsInsecureSkipVerify.IsNull() && !config.TlsInsecureSkipVerify.IsUnknown() {
+		tlsSettings.InsecureSkipVerify = config.TlsInsecureSkipVerify.ValueBool()
+	} else {
+		tlsSettings.InsecureSkipVerify, _ = strconv.ParseBool(os.Getenv("AXONOPS_TLS_INSECURE_SKIP_VERIFY"))
 	}
 
-	// Default axonops_host uses org_id: dash.axonops.cloud/<org_id>
-	if axonopsHost == "" {
-		axonopsHost = "dash.axonops.cloud/" + config.OrgId.ValueString()
+	if (tlsSettings.ClientCertFile == "") != (tlsSettings.ClientKeyFile == "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tls_client_cert"),
+			"Incomplete Client Certificate",
+			"tls_client_cert and tls_client_key must be set together.",
+		)
+	}
+
+	if orgId == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("org_id"),
+			"Missing required argument",
+			"The argument \"org_id\" is required, but no definition was found. Set it in the provider configuration or with the AXONOPS_ORG_ID environment variable.",
+		)
+		return
+	}
+
+	if protocol == "" {
+		protocol = "https"
 	}
 
-	if !config.ApiKey.IsNull() {
-		apiKey = config.ApiKey.ValueString()
+	// Default axonops_host uses org_id: dash.axonops.cloud/<org_id>
+	if axonopsHost == "" {
+		axonopsHost = "dash.axonops.cloud/" + orgId
 	}
 
-	if !config.TokenType.IsNull() {
-		tokenType = config.TokenType.ValueString()
+	if tokenType == "" {
+		tokenType = "Bearer"
+	} else {
 		if tokenType != "AxonApi" && tokenType != "Bearer" {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("token_type"),
@@ -75,18 +137,51 @@ func (p *axonopsProvider) Configure(ctx context.Context, req provider.ConfigureR
 		}
 	}
 
+	httpTimeout := defaultHttpTimeout
+	if !config.HttpTimeout.IsNull() && !config.HttpTimeout.IsUnknown() {
+		httpTimeout = time.Duration(config.HttpTimeout.ValueInt64()) * time.Second
+	} else if env := os.Getenv("AXONOPS_HTTP_TIMEOUT"); env != "" {
+		seconds, err := strconv.Atoi(env)
+		if err != nil || seconds < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_timeout"),
+				"Invalid HTTP Timeout",
+				fmt.Sprintf("AXONOPS_HTTP_TIMEOUT must be a positive number of seconds, got: %s", env),
+			)
+		}
+		httpTimeout = time.Duration(seconds) * time.Second
+	}
+
+	maxConnections := intConfigOrEnv(config.MaxConnections, "AXONOPS_MAX_CONNECTIONS", defaultMaxConnections, 1, path.Root("max_connections"), &resp.Diagnostics)
+	connectionTimeout := intConfigOrEnv(config.ConnectionTimeoutSeconds, "AXONOPS_CONNECTION_TIMEOUT_SECONDS", defaultConnectionTimeoutSeconds, 0, path.Root("connection_timeout_seconds"), &resp.Diagnostics)
+	connection := axonopsClient.ConnectionConfig{
+		MaxConnections:  int(maxConnections),
+		IdleConnTimeout: time.Duration(connectionTimeout) * time.Second,
+		// Without an idle timeout connections aren't reused at all
+		DisableKeepAlives: connectionTimeout == 0,
+	}
+
+	if proxy.URL != "" {
+		if _, err := url.Parse(proxy.URL); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_proxy"),
+				"Invalid Proxy URL",
+				fmt.Sprintf("http_proxy must be a valid URL: %s", err),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client := axonopsClient.CreateHTTPClient(protocol, axonopsHost, apiKey, config.OrgId.ValueString(), tokenType)
+	client, err := axonopsClient.CreateHTTPClient(protocol, axonopsHost, apiKey, orgId, tokenType, httpTimeout, proxy, tlsSettings, connection)
 
-	if client == nil {
+	if err != nil {
 		tflog.Error(ctx, "Client not initialised")
-		resp.Diagnostics.AddAttributeError(
-			path.Root("http_client"),
+		resp.Diagnostics.AddError(
 			"Error creating connection to AxonOps",
-			"Failed to initialise HTTP client for AxonOps API",
+			fmt.Sprintf("Failed to initialise HTTP client for AxonOps API: %s", err),
 		)
 	}
 
@@ -94,10 +189,59 @@ func (p *axonopsProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	debug := os.Getenv("AXONOPS_DEBUG") != ""
+	if !config.Debug.IsNull() && !config.Debug.IsUnknown() {
+		debug = config.Debug.ValueBool()
+	}
+	client.SetDebug(debug)
+	if debug && proxy.URL != "" {
+		if proxyUrl, err := url.Parse(proxy.URL); err == nil {
+			tflog.Debug(ctx, fmt.Sprintf("Using proxy %s", proxyUrl.Redacted()))
+		}
+	}
+
+	// Fail fast on bad credentials or an unreachable host rather than on the first resource operation
+	if skip, _ := strconv.ParseBool(os.Getenv("AXONOPS_SKIP_VERIFY")); !skip {
+		if err := client.Ping(ctx); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to connect to AxonOps",
+				fmt.Sprintf("Verifying the connection to %s://%s failed: %s. Set AXONOPS_SKIP_VERIFY=true to skip this check.", protocol, axonopsHost, err),
+			)
+			return
+		}
+	}
+
+	resp.DataSourceData = client
 	resp.ResourceData = client
 
 }
 
+// configOrEnv returns the configured value, or the environment variable when it isn't set
+func configOrEnv(value types.String, envVar string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
+// intConfigOrEnv returns the configured number, or the environment variable when it isn't set,
+// or def when neither is. An environment value that isn't a number of at least min is reported on attr.
+func intConfigOrEnv(value types.Int64, envVar string, def, min int64, attr path.Path, diags *diag.Diagnostics) int64 {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueInt64()
+	}
+	env := os.Getenv(envVar)
+	if env == "" {
+		return def
+	}
+	n, err := strconv.ParseInt(env, 10, 64)
+	if err != nil || n < min {
+		diags.AddAttributeError(attr, "Invalid Environment Variable", fmt.Sprintf("%s must be a number of at least %d, got: %s", envVar, min, env))
+		return def
+	}
+	return n
+}
+
 func (p *axonopsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "axonops"
 }
@@ -105,33 +249,78 @@ func (p *axonopsProvider) Metadata(ctx context.Context, req provider.MetadataReq
 func (p *axonopsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewKafkaTopicDataSource,
+		NewKafkaTopicsDataSource,
+		NewKafkaTopicConfigKeysDataSource,
+		NewConsumerGroupDataSource,
+		NewConsumerGroupsDataSource,
 		NewKafkaACLDataSource,
+		NewKafkaACLByPrincipalDataSource,
+		NewKafkaACLsDataSource,
 		NewKafkaConnectConnectorDataSource,
+		NewKafkaConnectClustersDataSource,
+		NewKafkaConnectConnectorsDataSource,
+		NewConnectorStatusDataSource,
 		NewSchemaDataSource,
+		NewSchemaByIDDataSource,
+		NewSchemaSubjectsDataSource,
+		NewSchemaRegistryConfigDataSource,
 		NewLogCollectorDataSource,
+		NewLogCollectorsDataSource,
 		NewTCPHealthcheckDataSource,
 		NewHTTPHealthcheckDataSource,
 		NewShellHealthcheckDataSource,
 		NewCassandraAdaptiveRepairDataSource,
+		NewCassandraAdaptiveRepairStatusDataSource,
+		NewCassandraRepairHistoryDataSource,
 		NewCassandraBackupDataSource,
+		NewCassandraBackupHistoryDataSource,
+		NewDseWorkloadDataSource,
 		NewMetricAlertRuleDataSource,
+		NewMetricAlertRulesDataSource,
+		NewIntegrationsDataSource,
+		NewAlertRouteDataSource,
+		NewAlertRoutesDataSource,
+		NewKafkaClusterVersionDataSource,
+		NewKafkaClusterInfoDataSource,
+		NewKafkaBrokerConfigDataSource,
 	}
 }
 
 func (p *axonopsProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewKafkaTopicResource,
+		NewKafkaClusterPolicyResource,
 		NewKafkaACLResource,
+		NewKafkaACLBatchResource,
+		NewKafkaTopicACLResource,
+		NewKafkaQuotaResource,
+		NewKafkaConsumerGroupResetResource,
+		NewKafkaBrokerConfigResource,
+		NewKafkaConsumerGroupConfigResource,
 		NewKafkaConnectConnectorResource,
 		NewSchemaResource,
+		NewSchemaCompatibilityResource,
+		NewSchemaSubjectModeResource,
+		NewSchemaNamingStrategyResource,
+		NewSchemaRegistryConfigResource,
 		NewLogCollectorResource,
 		NewTCPHealthcheckResource,
 		NewHTTPHealthcheckResource,
 		NewShellHealthcheckResource,
 		NewCassandraAdaptiveRepairResource,
+		NewCassandraRollingRestartResource,
 		NewCassandraBackupResource,
+		NewCassandraSnapshotResource,
+		NewCassandraKeyspaceResource,
+		NewDseWorkloadResource,
 		NewMetricAlertRuleResource,
+		NewIntegrationDefinitionResource,
+		NewIntegrationWebhookResource,
+		NewIntegrationSlackResource,
+		NewIntegrationPagerDutyResource,
+		NewIntegrationEmailResource,
 		NewAlertRouteResource,
+		NewAlertRouteBatchResource,
 	}
 }
 
@@ -139,21 +328,80 @@ func (p *axonopsProvider) Schema(ctx context.Context, req provider.SchemaRequest
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				Optional: true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "API key for authentication. Can also be set with the AXONOPS_API_KEY environment variable.",
 			},
 			"axonops_host": schema.StringAttribute{
 				Optional:    true,
-				Description: "AxonOps server hostname. Default: dash.axonops.cloud/<org_id>",
+				Description: "AxonOps server hostname. Default: dash.axonops.cloud/<org_id>. Can also be set with the AXONOPS_HOST environment variable.",
 			},
 			"axonops_protocol": schema.StringAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Protocol used to reach AxonOps (http or https). Default: https. Can also be set with the AXONOPS_PROTOCOL environment variable.",
 			},
 			"org_id": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Description: "Organization ID. Required, either here or with the AXONOPS_ORG_ID environment variable.",
 			},
 			"token_type": schema.StringAttribute{
 				Optional:    true,
-				Description: "Token type for Authorization header. Valid values: 'Bearer' (default) or 'AxonApi'",
+				Description: "Token type for Authorization header. Valid values: 'Bearer' (default) or 'AxonApi'. Can also be set with the AXONOPS_TOKEN_TYPE environment variable.",
+			},
+			"http_proxy": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the HTTP proxy used to reach AxonOps (e.g., http://proxy.example.com:3128). When not set, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used.",
+			},
+			"http_proxy_username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Username for proxy authentication. Can also be set with the AXONOPS_HTTP_PROXY_USERNAME environment variable.",
+			},
+			"http_proxy_password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password for proxy authentication. Can also be set with the AXONOPS_HTTP_PROXY_PASSWORD environment variable.",
+			},
+			"tls_client_cert": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM client certificate for mutual TLS. Requires tls_client_key. Can also be set with the AXONOPS_TLS_CLIENT_CERT environment variable.",
+			},
+			"tls_client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Path to the PEM private key of tls_client_cert. Can also be set with the AXONOPS_TLS_CLIENT_KEY environment variable.",
+			},
+			"tls_ca_cert": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM CA certificate used to verify the AxonOps server instead of the system roots. Can also be set with the AXONOPS_TLS_CA_CERT environment variable.",
+			},
+			"tls_insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip verification of the AxonOps server certificate. Only use this in development. Default: false. Can also be set with the AXONOPS_TLS_INSECURE_SKIP_VERIFY environment variable.",
+			},
+			"http_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout in seconds for each request to the AxonOps API. Default: 30. Can also be set with the AXONOPS_HTTP_TIMEOUT environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_connections": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of idle connections to AxonOps kept open for reuse. Raise it for applies with high parallelism. Default: 10. Can also be set with the AXONOPS_MAX_CONNECTIONS environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"connection_timeout_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long in seconds an idle connection to AxonOps is kept open for reuse. 0 disables keep-alives, opening a new connection for every request. Default: 30. Can also be set with the AXONOPS_CONNECTION_TIMEOUT_SECONDS environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"debug": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Log API requests and responses at debug level, shown with TF_LOG=DEBUG. Credentials in headers are masked. Default: false. Can also be enabled by setting the AXONOPS_DEBUG environment variable.",
 			},
 		},
 	}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// newTestClient returns a client that sends its requests to handler
+func newTestClient(t *testing.T, handler http.Handler) *axonopsClient.AxonopsHttpClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("invalid test server URL: %s", err)
+	}
+
+	client, err := axonopsClient.CreateHTTPClient("http", serverUrl.Host, "test-key", "test-org", "Bearer", 5*time.Second,
+		axonopsClient.ProxyConfig{}, axonopsClient.TLSConfig{}, axonopsClient.ConnectionConfig{MaxConnections: 2, IdleConnTimeout: time.Second})
+	if err != nil {
+		t.Fatalf("unable to create client: %s", err)
+	}
+	return client
+}
+
+// resourceSchema returns the schema of r
+func resourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("invalid schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// newTestState builds state of the given schema from a resource data struct
+func newTestState(t *testing.T, s schema.Schema, data interface{}) tfsdk.State {
+	t.Helper()
+
+	state := tfsdk.State{Schema: s}
+	if diags := state.Set(context.Background(), data); diags.HasError() {
+		t.Fatalf("unable to build state: %v", diags)
+	}
+	return state
+}
+
+// newTestPlan builds a plan of the given schema from a resource data struct
+func newTestPlan(t *testing.T, s schema.Schema, data interface{}) tfsdk.Plan {
+	t.Helper()
+
+	plan := tfsdk.Plan{Schema: s}
+	if diags := plan.Set(context.Background(), data); diags.HasError() {
+		t.Fatalf("unable to build plan: %v", diags)
+	}
+	return plan
+}
+
+// newTestConfig builds a config of the given schema from a resource data struct
+func newTestConfig(t *testing.T, s schema.Schema, data interface{}) tfsdk.Config {
+	t.Helper()
+
+	state := newTestState(t, s, data)
+	return tfsdk.Config{Schema: s, Raw: state.Raw}
+}
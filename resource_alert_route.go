@@ -3,14 +3,17 @@ package main
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	axonopsClient "terraform-provider-axonops/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -30,6 +33,19 @@ var routeTypeMap = map[string]string{
 	"rollingrestart": "Rolling%20Restart",
 }
 
+// integrationTypes are the integration types alerts can be routed to
+var integrationTypes = []string{"email", "smtp", "pagerduty", "slack", "teams", "servicenow", "webhook", "opsgenie"}
+
+// alertRouteTypes returns the Terraform route type names, sorted
+func alertRouteTypes() []string {
+	routeTypes := make([]string, 0, len(routeTypeMap))
+	for routeType := range routeTypeMap {
+		routeTypes = append(routeTypes, routeType)
+	}
+	sort.Strings(routeTypes)
+	return routeTypes
+}
+
 type alertRouteResource struct {
 	client *axonopsClient.AxonopsHttpClient
 }
@@ -78,10 +94,16 @@ func (r *alertRouteResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"integration_type": schema.StringAttribute{
 				Required:    true,
 				Description: "The type of integration: email, smtp, pagerduty, slack, teams, servicenow, webhook, opsgenie.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(integrationTypes...),
+				},
 			},
 			"type": schema.StringAttribute{
 				Required:    true,
 				Description: "The route type: global, metrics, backups, servicechecks, nodes, commands, repairs, rollingrestart.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertRouteTypes()...),
+				},
 			},
 			"severity": schema.StringAttribute{
 				Required:    true,
@@ -108,7 +130,7 @@ type alertRouteResourceData struct {
 }
 
 // findIntegrationID looks up the integration ID by name and type
-func (r *alertRouteResource) findIntegrationID(integrations *axonopsClient.IntegrationsResponse, intName, intType string) (string, error) {
+func findIntegrationID(integrations *axonopsClient.IntegrationsResponse, intName, intType string) (string, error) {
 	for _, def := range integrations.Definitions {
 		if strings.EqualFold(def.Type, intType) && strings.EqualFold(def.Params["name"], intName) {
 			return def.ID, nil
@@ -118,7 +140,7 @@ func (r *alertRouteResource) findIntegrationID(integrations *axonopsClient.Integ
 }
 
 // getAPIRouteType converts the Terraform route type to the API URL-encoded type
-func (r *alertRouteResource) getAPIRouteType(tfType string) (string, error) {
+func getAPIRouteType(tfType string) (string, error) {
 	apiType, ok := routeTypeMap[tfType]
 	if !ok {
 		return "", fmt.Errorf("unknown route type: %s", tfType)
@@ -135,20 +157,20 @@ func (r *alertRouteResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	apiRouteType, err := r.getAPIRouteType(data.RouteType.ValueString())
+	apiRouteType, err := getAPIRouteType(data.RouteType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Configuration Error", err.Error())
 		return
 	}
 
 	// Get integrations to find the integration ID
-	integrations, err := r.client.GetIntegrations(data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	integrations, err := r.client.GetIntegrations(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
 		return
 	}
 
-	integrationID, err := r.findIntegrationID(integrations, data.IntegrationName.ValueString(), data.IntegrationType.ValueString())
+	integrationID, err := findIntegrationID(integrations, data.IntegrationName.ValueString(), data.IntegrationType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
@@ -156,7 +178,7 @@ func (r *alertRouteResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Set override if non-global and enabled
 	if data.RouteType.ValueString() != "global" && data.EnableOverride.ValueBool() {
-		err = r.client.SetIntegrationOverride(data.ClusterType.ValueString(), data.ClusterName.ValueString(), apiRouteType, data.Severity.ValueString(), true)
+		err = r.client.SetIntegrationOverride(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), apiRouteType, data.Severity.ValueString(), true)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set override: %s", err))
 			return
@@ -164,7 +186,7 @@ func (r *alertRouteResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Add the route
-	err = r.client.AddIntegrationRoute(data.ClusterType.ValueString(), data.ClusterName.ValueString(), apiRouteType, data.Severity.ValueString(), integrationID)
+	err = r.client.AddIntegrationRoute(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), apiRouteType, data.Severity.ValueString(), integrationID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add route: %s", err))
 		return
@@ -185,20 +207,20 @@ func (r *alertRouteResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	apiRouteType, err := r.getAPIRouteType(data.RouteType.ValueString())
+	apiRouteType, err := getAPIRouteType(data.RouteType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Configuration Error", err.Error())
 		return
 	}
 
 	// Get integrations
-	integrations, err := r.client.GetIntegrations(data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	integrations, err := r.client.GetIntegrations(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
 		return
 	}
 
-	integrationID, err := r.findIntegrationID(integrations, data.IntegrationName.ValueString(), data.IntegrationType.ValueString())
+	integrationID, err := findIntegrationID(integrations, data.IntegrationName.ValueString(), data.IntegrationType.ValueString())
 	if err != nil {
 		// Integration no longer exists
 		resp.State.RemoveResource(ctx)
@@ -254,25 +276,25 @@ func (r *alertRouteResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Remove old route
-	oldAPIRouteType, err := r.getAPIRouteType(stateData.RouteType.ValueString())
+	oldAPIRouteType, err := getAPIRouteType(stateData.RouteType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Configuration Error", err.Error())
 		return
 	}
 
-	integrations, err := r.client.GetIntegrations(stateData.ClusterType.ValueString(), stateData.ClusterName.ValueString())
+	integrations, err := r.client.GetIntegrations(ctx, stateData.ClusterType.ValueString(), stateData.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
 		return
 	}
 
-	oldIntegrationID, err := r.findIntegrationID(integrations, stateData.IntegrationName.ValueString(), stateData.IntegrationType.ValueString())
+	oldIntegrationID, err := findIntegrationID(integrations, stateData.IntegrationName.ValueString(), stateData.IntegrationType.ValueString())
 	if err == nil {
-		_ = r.client.RemoveIntegrationRoute(stateData.ClusterType.ValueString(), stateData.ClusterName.ValueString(), oldAPIRouteType, stateData.Severity.ValueString(), oldIntegrationID)
+		_ = r.client.RemoveIntegrationRoute(ctx, stateData.ClusterType.ValueString(), stateData.ClusterName.ValueString(), oldAPIRouteType, stateData.Severity.ValueString(), oldIntegrationID)
 	}
 
 	// Add new route
-	newAPIRouteType, err := r.getAPIRouteType(planData.RouteType.ValueString())
+	newAPIRouteType, err := getAPIRouteType(planData.RouteType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Configuration Error", err.Error())
 		return
@@ -280,14 +302,14 @@ func (r *alertRouteResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Re-fetch integrations if cluster changed
 	if planData.ClusterName.ValueString() != stateData.ClusterName.ValueString() || planData.ClusterType.ValueString() != stateData.ClusterType.ValueString() {
-		integrations, err = r.client.GetIntegrations(planData.ClusterType.ValueString(), planData.ClusterName.ValueString())
+		integrations, err = r.client.GetIntegrations(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
 			return
 		}
 	}
 
-	newIntegrationID, err := r.findIntegrationID(integrations, planData.IntegrationName.ValueString(), planData.IntegrationType.ValueString())
+	newIntegrationID, err := findIntegrationID(integrations, planData.IntegrationName.ValueString(), planData.IntegrationType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
@@ -295,14 +317,14 @@ func (r *alertRouteResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Set override
 	if planData.RouteType.ValueString() != "global" && planData.EnableOverride.ValueBool() {
-		err = r.client.SetIntegrationOverride(planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), newAPIRouteType, planData.Severity.ValueString(), true)
+		err = r.client.SetIntegrationOverride(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), newAPIRouteType, planData.Severity.ValueString(), true)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set override: %s", err))
 			return
 		}
 	}
 
-	err = r.client.AddIntegrationRoute(planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), newAPIRouteType, planData.Severity.ValueString(), newIntegrationID)
+	err = r.client.AddIntegrationRoute(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), newAPIRouteType, planData.Severity.ValueString(), newIntegrationID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add route: %s", err))
 		return
@@ -323,25 +345,25 @@ func (r *alertRouteResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	apiRouteType, err := r.getAPIRouteType(data.RouteType.ValueString())
+	apiRouteType, err := getAPIRouteType(data.RouteType.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Configuration Error", err.Error())
 		return
 	}
 
-	integrations, err := r.client.GetIntegrations(data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	integrations, err := r.client.GetIntegrations(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
 		return
 	}
 
-	integrationID, err := r.findIntegrationID(integrations, data.IntegrationName.ValueString(), data.IntegrationType.ValueString())
+	integrationID, err := findIntegrationID(integrations, data.IntegrationName.ValueString(), data.IntegrationType.ValueString())
 	if err != nil {
 		// Integration already gone, nothing to delete
 		return
 	}
 
-	err = r.client.RemoveIntegrationRoute(data.ClusterType.ValueString(), data.ClusterName.ValueString(), apiRouteType, data.Severity.ValueString(), integrationID)
+	err = r.client.RemoveIntegrationRoute(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), apiRouteType, data.Severity.ValueString(), integrationID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove route: %s", err))
 		return
@@ -370,20 +392,20 @@ func (r *alertRouteResource) ImportState(ctx context.Context, req resource.Impor
 	integrationName := parts[5]
 
 	// Validate route type
-	_, err := r.getAPIRouteType(routeType)
+	_, err := getAPIRouteType(routeType)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", err.Error())
 		return
 	}
 
 	// Verify the integration exists
-	integrations, err := r.client.GetIntegrations(clusterType, clusterName)
+	integrations, err := r.client.GetIntegrations(ctx, clusterType, clusterName)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to get integrations: %s", err))
 		return
 	}
 
-	_, err = r.findIntegrationID(integrations, integrationName, integrationType)
+	_, err = findIntegrationID(integrations, integrationName, integrationType)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", err.Error())
 		return
@@ -392,7 +414,7 @@ func (r *alertRouteResource) ImportState(ctx context.Context, req resource.Impor
 	// Read override state
 	enableOverride := false
 	if routeType != "global" {
-		apiRouteType, _ := r.getAPIRouteType(routeType)
+		apiRouteType, _ := getAPIRouteType(routeType)
 		decodedAPIRouteType := strings.ReplaceAll(apiRouteType, "%20", " ")
 		for _, routing := range integrations.Routings {
 			if routing.Type == decodedAPIRouteType {
@@ -0,0 +1,443 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*alertRouteBatchResource)(nil)
+var _ resource.ResourceWithImportState = (*alertRouteBatchResource)(nil)
+
+// alertSeverities lists the severities in the order they are applied
+var alertSeverities = []string{"info", "warning", "error"}
+
+type alertRouteBatchResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewAlertRouteBatchResource() resource.Resource {
+	return &alertRouteBatchResource{}
+}
+
+func (r *alertRouteBatchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *alertRouteBatchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_route_batch"
+}
+
+func (r *alertRouteBatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the info, warning and error alert routes to an integration in a single resource.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+			},
+			"integration_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the integration.",
+			},
+			"integration_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of integration: email, smtp, pagerduty, slack, teams, servicenow, webhook, opsgenie.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(integrationTypes...),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The route type: global, metrics, backups, servicechecks, nodes, commands, repairs, rollingrestart.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(alertRouteTypes()...),
+				},
+			},
+			"route_info": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Route info alerts to the integration. Default: false",
+			},
+			"route_warning": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Route warning alerts to the integration. Default: false",
+			},
+			"route_error": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Route error alerts to the integration. Default: false",
+			},
+			"enable_override": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Enable override for non-global routes. Ignored for global routes. Default: true",
+			},
+		},
+	}
+}
+
+type alertRouteBatchResourceData struct {
+	ClusterName     types.String `tfsdk:"cluster_name"`
+	ClusterType     types.String `tfsdk:"cluster_type"`
+	IntegrationName types.String `tfsdk:"integration_name"`
+	IntegrationType types.String `tfsdk:"integration_type"`
+	RouteType       types.String `tfsdk:"type"`
+	RouteInfo       types.Bool   `tfsdk:"route_info"`
+	RouteWarning    types.Bool   `tfsdk:"route_warning"`
+	RouteError      types.Bool   `tfsdk:"route_error"`
+	EnableOverride  types.Bool   `tfsdk:"enable_override"`
+}
+
+// enabledSeverities returns which severities are routed to the integration
+func (d *alertRouteBatchResourceData) enabledSeverities() map[string]bool {
+	return map[string]bool{
+		"info":    d.RouteInfo.ValueBool(),
+		"warning": d.RouteWarning.ValueBool(),
+		"error":   d.RouteError.ValueBool(),
+	}
+}
+
+// setSeverity records whether a severity is routed to the integration
+func (d *alertRouteBatchResourceData) setSeverity(severity string, enabled bool) {
+	switch severity {
+	case "info":
+		d.RouteInfo = types.BoolValue(enabled)
+	case "warning":
+		d.RouteWarning = types.BoolValue(enabled)
+	case "error":
+		d.RouteError = types.BoolValue(enabled)
+	}
+}
+
+// addRoute sets the override (for non-global routes) and adds the route for a single severity
+func (r *alertRouteBatchResource) addRoute(ctx context.Context, data alertRouteBatchResourceData, apiRouteType, severity, integrationID string) error {
+	if data.RouteType.ValueString() != "global" && data.EnableOverride.ValueBool() {
+		err := r.client.SetIntegrationOverride(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), apiRouteType, severity, true)
+		if err != nil {
+			return fmt.Errorf("unable to set %s override: %w", severity, err)
+		}
+	}
+
+	err := r.client.AddIntegrationRoute(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), apiRouteType, severity, integrationID)
+	if err != nil {
+		return fmt.Errorf("unable to add %s route: %w", severity, err)
+	}
+
+	return nil
+}
+
+func (r *alertRouteBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data alertRouteBatchResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRouteType, err := getAPIRouteType(data.RouteType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", err.Error())
+		return
+	}
+
+	// Get integrations to find the integration ID
+	integrations, err := r.client.GetIntegrations(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
+		return
+	}
+
+	integrationID, err := findIntegrationID(integrations, data.IntegrationName.ValueString(), data.IntegrationType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	enabled := data.enabledSeverities()
+	for _, severity := range alertSeverities {
+		if !enabled[severity] {
+			continue
+		}
+
+		if err := r.addRoute(ctx, data, apiRouteType, severity, integrationID); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Created alert route batch resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertRouteBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data alertRouteBatchResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRouteType, err := getAPIRouteType(data.RouteType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", err.Error())
+		return
+	}
+
+	// Get integrations
+	integrations, err := r.client.GetIntegrations(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
+		return
+	}
+
+	integrationID, err := findIntegrationID(integrations, data.IntegrationName.ValueString(), data.IntegrationType.ValueString())
+	if err != nil {
+		// Integration no longer exists
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	routed := readRoutedSeverities(integrations, apiRouteType, integrationID)
+	for _, severity := range alertSeverities {
+		data.setSeverity(severity, routed[severity])
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertRouteBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planData alertRouteBatchResourceData
+	var stateData alertRouteBatchResourceData
+
+	diags := req.Plan.Get(ctx, &planData)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &stateData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oldAPIRouteType, err := getAPIRouteType(stateData.RouteType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", err.Error())
+		return
+	}
+
+	newAPIRouteType, err := getAPIRouteType(planData.RouteType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", err.Error())
+		return
+	}
+
+	integrations, err := r.client.GetIntegrations(ctx, stateData.ClusterType.ValueString(), stateData.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
+		return
+	}
+
+	oldIntegrationID, oldErr := findIntegrationID(integrations, stateData.IntegrationName.ValueString(), stateData.IntegrationType.ValueString())
+
+	oldEnabled := stateData.enabledSeverities()
+	newEnabled := planData.enabledSeverities()
+
+	// If the route target changed, remove every old route and add the new ones from scratch
+	targetChanged := planData.ClusterName.ValueString() != stateData.ClusterName.ValueString() ||
+		planData.ClusterType.ValueString() != stateData.ClusterType.ValueString() ||
+		planData.RouteType.ValueString() != stateData.RouteType.ValueString() ||
+		!strings.EqualFold(planData.IntegrationName.ValueString(), stateData.IntegrationName.ValueString()) ||
+		!strings.EqualFold(planData.IntegrationType.ValueString(), stateData.IntegrationType.ValueString())
+
+	for _, severity := range alertSeverities {
+		if oldEnabled[severity] && (targetChanged || !newEnabled[severity]) && oldErr == nil {
+			err = r.client.RemoveIntegrationRoute(ctx, stateData.ClusterType.ValueString(), stateData.ClusterName.ValueString(), oldAPIRouteType, severity, oldIntegrationID)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s route: %s", severity, err))
+				return
+			}
+		}
+	}
+
+	// Re-fetch integrations if cluster changed
+	if planData.ClusterName.ValueString() != stateData.ClusterName.ValueString() || planData.ClusterType.ValueString() != stateData.ClusterType.ValueString() {
+		integrations, err = r.client.GetIntegrations(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
+			return
+		}
+	}
+
+	newIntegrationID, err := findIntegrationID(integrations, planData.IntegrationName.ValueString(), planData.IntegrationType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	for _, severity := range alertSeverities {
+		if newEnabled[severity] && (targetChanged || !oldEnabled[severity]) {
+			if err := r.addRoute(ctx, planData, newAPIRouteType, severity, newIntegrationID); err != nil {
+				resp.Diagnostics.AddError("Client Error", err.Error())
+				return
+			}
+		}
+	}
+
+	tflog.Info(ctx, "Updated alert route batch resource")
+
+	diags = resp.State.Set(ctx, &planData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *alertRouteBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data alertRouteBatchResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRouteType, err := getAPIRouteType(data.RouteType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", err.Error())
+		return
+	}
+
+	integrations, err := r.client.GetIntegrations(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations: %s", err))
+		return
+	}
+
+	integrationID, err := findIntegrationID(integrations, data.IntegrationName.ValueString(), data.IntegrationType.ValueString())
+	if err != nil {
+		// Integration already gone, nothing to delete
+		return
+	}
+
+	// Remove every severity that is currently routed, regardless of what state recorded
+	routed := readRoutedSeverities(integrations, apiRouteType, integrationID)
+	for _, severity := range alertSeverities {
+		if !routed[severity] {
+			continue
+		}
+
+		err = r.client.RemoveIntegrationRoute(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), apiRouteType, severity, integrationID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s route: %s", severity, err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Deleted alert route batch resource")
+}
+
+// ImportState imports the alert routes for an integration.
+// Import ID format: cluster_type/cluster_name/type/integration_type/integration_name
+func (r *alertRouteBatchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 5 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/type/integration_type/integration_name, got: %s", req.ID),
+		)
+		return
+	}
+
+	clusterType := parts[0]
+	clusterName := parts[1]
+	routeType := parts[2]
+	integrationType := parts[3]
+	integrationName := parts[4]
+
+	apiRouteType, err := getAPIRouteType(routeType)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", err.Error())
+		return
+	}
+
+	// Verify the integration exists
+	integrations, err := r.client.GetIntegrations(ctx, clusterType, clusterName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to get integrations: %s", err))
+		return
+	}
+
+	integrationID, err := findIntegrationID(integrations, integrationName, integrationType)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", err.Error())
+		return
+	}
+
+	routed := readRoutedSeverities(integrations, apiRouteType, integrationID)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), routeType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_type"), integrationType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integration_name"), integrationName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("route_info"), routed["info"])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("route_warning"), routed["warning"])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("route_error"), routed["error"])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enable_override"), true)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported alert route batch for %s/%s type=%s", clusterType, clusterName, routeType))
+}
+
+// readRoutedSeverities returns which severities of a route type are routed to the given integration
+func readRoutedSeverities(integrations *axonopsClient.IntegrationsResponse, apiRouteType, integrationID string) map[string]bool {
+	routed := map[string]bool{}
+
+	// Decode the API route type for comparison (URL-decode %20 to space)
+	decodedAPIRouteType := strings.ReplaceAll(apiRouteType, "%20", " ")
+	for _, routing := range integrations.Routings {
+		if routing.Type != decodedAPIRouteType {
+			continue
+		}
+		for _, route := range routing.Routing {
+			if route.ID == integrationID {
+				routed[strings.ToLower(route.Severity)] = true
+			}
+		}
+	}
+
+	return routed
+}
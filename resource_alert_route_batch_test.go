@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeRoutingAPI serves the integrations of a cluster and records every routing change
+type fakeRoutingAPI struct {
+	integrations axonopsClient.IntegrationsResponse
+	changes      []string
+}
+
+func (f *fakeRoutingAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet && r.URL.Path == "/api/v1/integrations/test-org/kafka/prod" {
+		json.NewEncoder(w).Encode(f.integrations)
+		return
+	}
+	f.changes = append(f.changes, r.Method+" "+r.URL.EscapedPath())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newFakeRoutingAPI(routings ...axonopsClient.IntegrationRouting) *fakeRoutingAPI {
+	return &fakeRoutingAPI{
+		integrations: axonopsClient.IntegrationsResponse{
+			Definitions: []axonopsClient.IntegrationDefinition{
+				{ID: "int-1", Type: "slack", Params: map[string]string{"name": "ops"}},
+			},
+			Routings: routings,
+		},
+	}
+}
+
+func testAlertRouteBatchData(routeType string, info, warning, errorSeverity bool) alertRouteBatchResourceData {
+	return alertRouteBatchResourceData{
+		ClusterName:     types.StringValue("prod"),
+		ClusterType:     types.StringValue("kafka"),
+		IntegrationName: types.StringValue("ops"),
+		IntegrationType: types.StringValue("slack"),
+		RouteType:       types.StringValue(routeType),
+		RouteInfo:       types.BoolValue(info),
+		RouteWarning:    types.BoolValue(warning),
+		RouteError:      types.BoolValue(errorSeverity),
+		EnableOverride:  types.BoolValue(true),
+	}
+}
+
+func createAlertRouteBatch(t *testing.T, api *fakeRoutingAPI, data alertRouteBatchResourceData) {
+	t.Helper()
+
+	r := &alertRouteBatchResource{client: newTestClient(t, api)}
+	s := resourceSchema(t, r)
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newTestPlan(t, s, &data)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+}
+
+func TestAlertRouteBatchCreateWarningOnly(t *testing.T) {
+	api := newFakeRoutingAPI()
+	createAlertRouteBatch(t, api, testAlertRouteBatchData("global", false, true, false))
+
+	want := []string{"POST /api/v1/integrations-routing/test-org/kafka/prod/Global/warning/int-1"}
+	if !reflect.DeepEqual(api.changes, want) {
+		t.Errorf("routing changes = %v, want %v", api.changes, want)
+	}
+}
+
+func TestAlertRouteBatchCreateSetsOverrides(t *testing.T) {
+	api := newFakeRoutingAPI()
+	createAlertRouteBatch(t, api, testAlertRouteBatchData("servicechecks", true, false, true))
+
+	want := []string{
+		"PUT /api/v1/integrations-override/test-org/kafka/prod/Service%20Checks/info",
+		"POST /api/v1/integrations-routing/test-org/kafka/prod/Service%20Checks/info/int-1",
+		"PUT /api/v1/integrations-override/test-org/kafka/prod/Service%20Checks/error",
+		"POST /api/v1/integrations-routing/test-org/kafka/prod/Service%20Checks/error/int-1",
+	}
+	if !reflect.DeepEqual(api.changes, want) {
+		t.Errorf("routing changes = %v, want %v", api.changes, want)
+	}
+}
+
+func TestAlertRouteBatchCreateNothingRouted(t *testing.T) {
+	api := newFakeRoutingAPI()
+	createAlertRouteBatch(t, api, testAlertRouteBatchData("global", false, false, false))
+
+	if len(api.changes) != 0 {
+		t.Errorf("routing changes = %v, want none", api.changes)
+	}
+}
+
+func TestAlertRouteBatchUpdateDiffsSeverities(t *testing.T) {
+	api := newFakeRoutingAPI()
+	r := &alertRouteBatchResource{client: newTestClient(t, api)}
+	s := resourceSchema(t, r)
+
+	prior := testAlertRouteBatchData("global", true, true, false)
+	planned := testAlertRouteBatchData("global", true, false, true)
+
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: s}}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  newTestPlan(t, s, &planned),
+		State: newTestState(t, s, &prior),
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	// info is unchanged and left alone
+	want := []string{
+		"DELETE /api/v1/integrations-routing/test-org/kafka/prod/Global/warning/int-1",
+		"POST /api/v1/integrations-routing/test-org/kafka/prod/Global/error/int-1",
+	}
+	if !reflect.DeepEqual(api.changes, want) {
+		t.Errorf("routing changes = %v, want %v", api.changes, want)
+	}
+}
+
+func TestAlertRouteBatchReadPartialRouting(t *testing.T) {
+	api := newFakeRoutingAPI(
+		axonopsClient.IntegrationRouting{
+			Type: "Service Checks",
+			Routing: []axonopsClient.IntegrationRoute{
+				{ID: "int-1", Severity: "Warning"},
+				{ID: "int-2", Severity: "Error"},
+			},
+		},
+		axonopsClient.IntegrationRouting{
+			Type:    "Global",
+			Routing: []axonopsClient.IntegrationRoute{{ID: "int-1", Severity: "Info"}},
+		},
+	)
+	r := &alertRouteBatchResource{client: newTestClient(t, api)}
+	s := resourceSchema(t, r)
+
+	prior := testAlertRouteBatchData("servicechecks", true, true, true)
+	resp := resource.ReadResponse{State: newTestState(t, s, &prior)}
+	r.Read(context.Background(), resource.ReadRequest{State: newTestState(t, s, &prior)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var data alertRouteBatchResourceData
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+
+	// Only the warning route of this integration and route type counts
+	if data.RouteInfo.ValueBool() || !data.RouteWarning.ValueBool() || data.RouteError.ValueBool() {
+		t.Errorf("route_info = %s, route_warning = %s, route_error = %s, want false, true, false",
+			data.RouteInfo, data.RouteWarning, data.RouteError)
+	}
+}
@@ -7,7 +7,9 @@ import (
 
 	axonopsClient "terraform-provider-axonops/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,12 +17,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = (*cassandraAdaptiveRepairResource)(nil)
 var _ resource.ResourceWithImportState = (*cassandraAdaptiveRepairResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*cassandraAdaptiveRepairResource)(nil)
+
+// scheduleOverrideAttrTypes describes a single schedule_overrides element
+var scheduleOverrideAttrTypes = map[string]attr.Type{
+	"hour_start":      types.Int64Type,
+	"hour_end":        types.Int64Type,
+	"parallelism":     types.Int64Type,
+	"segment_retries": types.Int64Type,
+}
 
 type cassandraAdaptiveRepairResource struct {
 	client *axonopsClient.AxonopsHttpClient
@@ -53,7 +66,7 @@ func (r *cassandraAdaptiveRepairResource) Metadata(_ context.Context, req resour
 
 func (r *cassandraAdaptiveRepairResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages Cassandra adaptive repair settings for a cluster.",
+		Description: "Manages Cassandra adaptive repair settings for a cluster. Destroying this resource disables adaptive repair and leaves the other settings unchanged.",
 		Attributes: map[string]schema.Attribute{
 			"cluster_name": schema.StringAttribute{
 				Required:    true,
@@ -114,21 +127,167 @@ func (r *cassandraAdaptiveRepairResource) Schema(ctx context.Context, req resour
 				Default:     int64default.StaticInt64(256),
 				Description: "Target segment size in MB. Default: 256",
 			},
+			"max_concurrent_repairs": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Maximum number of repair jobs running simultaneously across the cluster. 0 means no limit. Default: 0",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"schedule_overrides": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Hour windows during which parallelism and segment retries are overridden, e.g. to repair less aggressively during business hours. Windows must not overlap.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hour_start": schema.Int64Attribute{
+							Required:    true,
+							Description: "First hour of the window (0-23).",
+						},
+						"hour_end": schema.Int64Attribute{
+							Required:    true,
+							Description: "Hour at which the window ends, exclusive (0-23). Windows may wrap past midnight.",
+						},
+						"parallelism": schema.Int64Attribute{
+							Required:    true,
+							Description: "Number of tables to repair concurrently during the window.",
+						},
+						"segment_retries": schema.Int64Attribute{
+							Required:    true,
+							Description: "Maximum retry attempts per segment during the window.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 type cassandraAdaptiveRepairResourceData struct {
-	ClusterName         types.String `tfsdk:"cluster_name"`
-	ClusterType         types.String `tfsdk:"cluster_type"`
-	Active              types.Bool   `tfsdk:"active"`
-	Parallelism         types.Int64  `tfsdk:"parallelism"`
-	GcGraceThreshold    types.Int64  `tfsdk:"gc_grace_threshold"`
-	BlacklistedTables   types.List   `tfsdk:"blacklisted_tables"`
-	FilterTwcsTables    types.Bool   `tfsdk:"filter_twcs_tables"`
-	SegmentRetries      types.Int64  `tfsdk:"segment_retries"`
-	SegmentsPerVnode    types.Int64  `tfsdk:"segments_per_vnode"`
-	SegmentTargetSizeMB types.Int64  `tfsdk:"segment_target_size_mb"`
+	ClusterName          types.String `tfsdk:"cluster_name"`
+	ClusterType          types.String `tfsdk:"cluster_type"`
+	Active               types.Bool   `tfsdk:"active"`
+	Parallelism          types.Int64  `tfsdk:"parallelism"`
+	GcGraceThreshold     types.Int64  `tfsdk:"gc_grace_threshold"`
+	BlacklistedTables    types.List   `tfsdk:"blacklisted_tables"`
+	FilterTwcsTables     types.Bool   `tfsdk:"filter_twcs_tables"`
+	SegmentRetries       types.Int64  `tfsdk:"segment_retries"`
+	SegmentsPerVnode     types.Int64  `tfsdk:"segments_per_vnode"`
+	SegmentTargetSizeMB  types.Int64  `tfsdk:"segment_target_size_mb"`
+	MaxConcurrentRepairs types.Int64  `tfsdk:"max_concurrent_repairs"`
+	ScheduleOverrides    types.List   `tfsdk:"schedule_overrides"`
+}
+
+type scheduleOverrideData struct {
+	HourStart      types.Int64 `tfsdk:"hour_start"`
+	HourEnd        types.Int64 `tfsdk:"hour_end"`
+	Parallelism    types.Int64 `tfsdk:"parallelism"`
+	SegmentRetries types.Int64 `tfsdk:"segment_retries"`
+}
+
+// overrideHours returns the hours covered by a window, wrapping past midnight when hour_end < hour_start
+func overrideHours(start, end int64) []int64 {
+	var hours []int64
+	for h := start; h != end; h = (h + 1) % 24 {
+		hours = append(hours, h)
+	}
+	return hours
+}
+
+func (r *cassandraAdaptiveRepairResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var overrides types.List
+
+	diags := req.Config.GetAttribute(ctx, path.Root("schedule_overrides"), &overrides)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || overrides.IsNull() || overrides.IsUnknown() {
+		return
+	}
+
+	// Track which override owns each hour to detect overlapping windows
+	owner := map[int64]int{}
+	for i, elem := range overrides.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsUnknown() {
+			continue
+		}
+
+		var o scheduleOverrideData
+		resp.Diagnostics.Append(obj.As(ctx, &o, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if o.HourStart.IsUnknown() || o.HourEnd.IsUnknown() {
+			continue
+		}
+
+		start := o.HourStart.ValueInt64()
+		end := o.HourEnd.ValueInt64()
+		elemPath := path.Root("schedule_overrides").AtListIndex(i)
+
+		if start < 0 || start > 23 {
+			resp.Diagnostics.AddAttributeError(elemPath.AtName("hour_start"), "Invalid Hour", fmt.Sprintf("hour_start must be between 0 and 23, got: %d", start))
+			continue
+		}
+		if end < 0 || end > 23 {
+			resp.Diagnostics.AddAttributeError(elemPath.AtName("hour_end"), "Invalid Hour", fmt.Sprintf("hour_end must be between 0 and 23, got: %d", end))
+			continue
+		}
+		if start == end {
+			resp.Diagnostics.AddAttributeError(elemPath, "Invalid Schedule Override", "hour_start and hour_end must differ.")
+			continue
+		}
+
+		for _, h := range overrideHours(start, end) {
+			if j, ok := owner[h]; ok {
+				resp.Diagnostics.AddAttributeError(
+					elemPath,
+					"Overlapping Schedule Overrides",
+					fmt.Sprintf("Schedule override %d overlaps schedule override %d at hour %d.", i, j, h),
+				)
+				break
+			}
+			owner[h] = i
+		}
+	}
+}
+
+// buildScheduleOverrides converts the schedule_overrides attribute to the API representation
+func buildScheduleOverrides(ctx context.Context, list types.List) ([]axonopsClient.AdaptiveRepairScheduleOverride, diag.Diagnostics) {
+	var overrides []scheduleOverrideData
+
+	diags := list.ElementsAs(ctx, &overrides, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var result []axonopsClient.AdaptiveRepairScheduleOverride
+	for _, o := range overrides {
+		result = append(result, axonopsClient.AdaptiveRepairScheduleOverride{
+			HourStart:        int(o.HourStart.ValueInt64()),
+			HourEnd:          int(o.HourEnd.ValueInt64()),
+			TableParallelism: int(o.Parallelism.ValueInt64()),
+			SegmentRetries:   int(o.SegmentRetries.ValueInt64()),
+		})
+	}
+
+	return result, diags
+}
+
+// flattenScheduleOverrides converts API schedule overrides to the schedule_overrides attribute
+func flattenScheduleOverrides(ctx context.Context, overrides []axonopsClient.AdaptiveRepairScheduleOverride) (types.List, diag.Diagnostics) {
+	elements := []scheduleOverrideData{}
+	for _, o := range overrides {
+		elements = append(elements, scheduleOverrideData{
+			HourStart:      types.Int64Value(int64(o.HourStart)),
+			HourEnd:        types.Int64Value(int64(o.HourEnd)),
+			Parallelism:    types.Int64Value(int64(o.TableParallelism)),
+			SegmentRetries: types.Int64Value(int64(o.SegmentRetries)),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: scheduleOverrideAttrTypes}, elements)
 }
 
 func (r *cassandraAdaptiveRepairResource) buildSettings(ctx context.Context, data *cassandraAdaptiveRepairResourceData, diags *[]interface{}) axonopsClient.AdaptiveRepairSettings {
@@ -139,14 +298,15 @@ func (r *cassandraAdaptiveRepairResource) buildSettings(ctx context.Context, dat
 	}
 
 	return axonopsClient.AdaptiveRepairSettings{
-		Active:              data.Active.ValueBool(),
-		GcGraceThreshold:    int(data.GcGraceThreshold.ValueInt64()),
-		TableParallelism:    int(data.Parallelism.ValueInt64()),
-		BlacklistedTables:   blacklisted,
-		FilterTWCSTables:    data.FilterTwcsTables.ValueBool(),
-		SegmentRetries:      int(data.SegmentRetries.ValueInt64()),
-		SegmentsPerVnode:    int(data.SegmentsPerVnode.ValueInt64()),
-		SegmentTargetSizeMB: int(data.SegmentTargetSizeMB.ValueInt64()),
+		Active:               data.Active.ValueBool(),
+		GcGraceThreshold:     int(data.GcGraceThreshold.ValueInt64()),
+		TableParallelism:     int(data.Parallelism.ValueInt64()),
+		BlacklistedTables:    blacklisted,
+		FilterTWCSTables:     data.FilterTwcsTables.ValueBool(),
+		SegmentRetries:       int(data.SegmentRetries.ValueInt64()),
+		SegmentsPerVnode:     int(data.SegmentsPerVnode.ValueInt64()),
+		SegmentTargetSizeMB:  int(data.SegmentTargetSizeMB.ValueInt64()),
+		MaxConcurrentRepairs: int(data.MaxConcurrentRepairs.ValueInt64()),
 	}
 }
 
@@ -170,17 +330,24 @@ func (r *cassandraAdaptiveRepairResource) Create(ctx context.Context, req resour
 	}
 
 	settings := axonopsClient.AdaptiveRepairSettings{
-		Active:              data.Active.ValueBool(),
-		GcGraceThreshold:    int(data.GcGraceThreshold.ValueInt64()),
-		TableParallelism:    int(data.Parallelism.ValueInt64()),
-		BlacklistedTables:   blacklisted,
-		FilterTWCSTables:    data.FilterTwcsTables.ValueBool(),
-		SegmentRetries:      int(data.SegmentRetries.ValueInt64()),
-		SegmentsPerVnode:    int(data.SegmentsPerVnode.ValueInt64()),
-		SegmentTargetSizeMB: int(data.SegmentTargetSizeMB.ValueInt64()),
+		Active:               data.Active.ValueBool(),
+		GcGraceThreshold:     int(data.GcGraceThreshold.ValueInt64()),
+		TableParallelism:     int(data.Parallelism.ValueInt64()),
+		BlacklistedTables:    blacklisted,
+		FilterTWCSTables:     data.FilterTwcsTables.ValueBool(),
+		SegmentRetries:       int(data.SegmentRetries.ValueInt64()),
+		SegmentsPerVnode:     int(data.SegmentsPerVnode.ValueInt64()),
+		SegmentTargetSizeMB:  int(data.SegmentTargetSizeMB.ValueInt64()),
+		MaxConcurrentRepairs: int(data.MaxConcurrentRepairs.ValueInt64()),
 	}
 
-	err := r.client.UpdateCassandraAdaptiveRepair(data.ClusterType.ValueString(), data.ClusterName.ValueString(), settings)
+	settings.ScheduleOverrides, diags = buildScheduleOverrides(ctx, data.ScheduleOverrides)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateCassandraAdaptiveRepair(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), settings)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set adaptive repair settings: %s", err))
 		return
@@ -201,7 +368,7 @@ func (r *cassandraAdaptiveRepairResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	settings, err := r.client.GetCassandraAdaptiveRepair(data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	settings, err := r.client.GetCassandraAdaptiveRepair(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read adaptive repair settings: %s", err))
 		return
@@ -214,6 +381,7 @@ func (r *cassandraAdaptiveRepairResource) Read(ctx context.Context, req resource
 	data.SegmentRetries = types.Int64Value(int64(settings.SegmentRetries))
 	data.SegmentsPerVnode = types.Int64Value(int64(settings.SegmentsPerVnode))
 	data.SegmentTargetSizeMB = types.Int64Value(int64(settings.SegmentTargetSizeMB))
+	data.MaxConcurrentRepairs = types.Int64Value(int64(settings.MaxConcurrentRepairs))
 
 	if settings.BlacklistedTables == nil {
 		settings.BlacklistedTables = []string{}
@@ -221,6 +389,12 @@ func (r *cassandraAdaptiveRepairResource) Read(ctx context.Context, req resource
 	data.BlacklistedTables, diags = types.ListValueFrom(ctx, types.StringType, settings.BlacklistedTables)
 	resp.Diagnostics.Append(diags...)
 
+	// Leave schedule_overrides null when none are configured or returned
+	if len(settings.ScheduleOverrides) > 0 || !data.ScheduleOverrides.IsNull() {
+		data.ScheduleOverrides, diags = flattenScheduleOverrides(ctx, settings.ScheduleOverrides)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -245,17 +419,24 @@ func (r *cassandraAdaptiveRepairResource) Update(ctx context.Context, req resour
 	}
 
 	settings := axonopsClient.AdaptiveRepairSettings{
-		Active:              data.Active.ValueBool(),
-		GcGraceThreshold:    int(data.GcGraceThreshold.ValueInt64()),
-		TableParallelism:    int(data.Parallelism.ValueInt64()),
-		BlacklistedTables:   blacklisted,
-		FilterTWCSTables:    data.FilterTwcsTables.ValueBool(),
-		SegmentRetries:      int(data.SegmentRetries.ValueInt64()),
-		SegmentsPerVnode:    int(data.SegmentsPerVnode.ValueInt64()),
-		SegmentTargetSizeMB: int(data.SegmentTargetSizeMB.ValueInt64()),
+		Active:               data.Active.ValueBool(),
+		GcGraceThreshold:     int(data.GcGraceThreshold.ValueInt64()),
+		TableParallelism:     int(data.Parallelism.ValueInt64()),
+		BlacklistedTables:    blacklisted,
+		FilterTWCSTables:     data.FilterTwcsTables.ValueBool(),
+		SegmentRetries:       int(data.SegmentRetries.ValueInt64()),
+		SegmentsPerVnode:     int(data.SegmentsPerVnode.ValueInt64()),
+		SegmentTargetSizeMB:  int(data.SegmentTargetSizeMB.ValueInt64()),
+		MaxConcurrentRepairs: int(data.MaxConcurrentRepairs.ValueInt64()),
+	}
+
+	settings.ScheduleOverrides, diags = buildScheduleOverrides(ctx, data.ScheduleOverrides)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := r.client.UpdateCassandraAdaptiveRepair(data.ClusterType.ValueString(), data.ClusterName.ValueString(), settings)
+	err := r.client.UpdateCassandraAdaptiveRepair(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), settings)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update adaptive repair settings: %s", err))
 		return
@@ -276,25 +457,22 @@ func (r *cassandraAdaptiveRepairResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	// Reset to defaults with Active=false
-	settings := axonopsClient.AdaptiveRepairSettings{
-		Active:              false,
-		GcGraceThreshold:    86400,
-		TableParallelism:    10,
-		BlacklistedTables:   []string{},
-		FilterTWCSTables:    true,
-		SegmentRetries:      3,
-		SegmentsPerVnode:    1,
-		SegmentTargetSizeMB: 256,
+	// Destroying the resource only disables adaptive repair; the other settings are
+	// written back unchanged since the API has no way to restore its defaults
+	settings, err := r.client.GetCassandraAdaptiveRepair(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read adaptive repair settings: %s", err))
+		return
 	}
+	settings.Active = false
 
-	err := r.client.UpdateCassandraAdaptiveRepair(data.ClusterType.ValueString(), data.ClusterName.ValueString(), settings)
+	err = r.client.UpdateCassandraAdaptiveRepair(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), *settings)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset adaptive repair settings: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable adaptive repair: %s", err))
 		return
 	}
 
-	tflog.Info(ctx, "Deleted (reset) Cassandra adaptive repair resource")
+	tflog.Info(ctx, "Deleted (disabled) Cassandra adaptive repair resource")
 }
 
 // ImportState imports existing adaptive repair settings.
@@ -312,7 +490,7 @@ func (r *cassandraAdaptiveRepairResource) ImportState(ctx context.Context, req r
 	clusterType := parts[0]
 	clusterName := parts[1]
 
-	settings, err := r.client.GetCassandraAdaptiveRepair(clusterType, clusterName)
+	settings, err := r.client.GetCassandraAdaptiveRepair(ctx, clusterType, clusterName)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read adaptive repair settings: %s", err))
 		return
@@ -327,6 +505,7 @@ func (r *cassandraAdaptiveRepairResource) ImportState(ctx context.Context, req r
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("segment_retries"), int64(settings.SegmentRetries))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("segments_per_vnode"), int64(settings.SegmentsPerVnode))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("segment_target_size_mb"), int64(settings.SegmentTargetSizeMB))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("max_concurrent_repairs"), int64(settings.MaxConcurrentRepairs))...)
 
 	blacklisted := settings.BlacklistedTables
 	if blacklisted == nil {
@@ -334,5 +513,11 @@ func (r *cassandraAdaptiveRepairResource) ImportState(ctx context.Context, req r
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("blacklisted_tables"), blacklisted)...)
 
+	if len(settings.ScheduleOverrides) > 0 {
+		overrides, diags := flattenScheduleOverrides(ctx, settings.ScheduleOverrides)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_overrides"), overrides)...)
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("Imported Cassandra adaptive repair settings for cluster %s/%s", clusterType, clusterName))
 }
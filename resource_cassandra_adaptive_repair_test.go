@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testAdaptiveRepairData(overrides types.List) cassandraAdaptiveRepairResourceData {
+	return cassandraAdaptiveRepairResourceData{
+		ClusterName:          types.StringValue("prod"),
+		ClusterType:          types.StringValue("cassandra"),
+		Active:               types.BoolValue(true),
+		Parallelism:          types.Int64Value(10),
+		GcGraceThreshold:     types.Int64Value(86400),
+		BlacklistedTables:    types.ListValueMust(types.StringType, []attr.Value{}),
+		FilterTwcsTables:     types.BoolValue(true),
+		SegmentRetries:       types.Int64Value(3),
+		SegmentsPerVnode:     types.Int64Value(1),
+		SegmentTargetSizeMB:  types.Int64Value(256),
+		MaxConcurrentRepairs: types.Int64Value(0),
+		ScheduleOverrides:    overrides,
+	}
+}
+
+func testScheduleOverrides(windows ...[4]int64) types.List {
+	elements := []attr.Value{}
+	for _, w := range windows {
+		elements = append(elements, types.ObjectValueMust(scheduleOverrideAttrTypes, map[string]attr.Value{
+			"hour_start":      types.Int64Value(w[0]),
+			"hour_end":        types.Int64Value(w[1]),
+			"parallelism":     types.Int64Value(w[2]),
+			"segment_retries": types.Int64Value(w[3]),
+		}))
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: scheduleOverrideAttrTypes}, elements)
+}
+
+// createAdaptiveRepair runs Create and returns the settings payload sent to the API
+func createAdaptiveRepair(t *testing.T, data cassandraAdaptiveRepairResourceData) map[string]json.RawMessage {
+	t.Helper()
+
+	var payload map[string]json.RawMessage
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/adaptiveRepair/test-org/cassandra/prod" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("invalid request body %s: %s", body, err)
+		}
+	}))
+
+	r := &cassandraAdaptiveRepairResource{client: client}
+	s := resourceSchema(t, r)
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newTestPlan(t, s, &data)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	return payload
+}
+
+func TestAdaptiveRepairCreateSendsScheduleOverrides(t *testing.T) {
+	payload := createAdaptiveRepair(t, testAdaptiveRepairData(testScheduleOverrides(
+		[4]int64{8, 18, 1, 1},
+		[4]int64{22, 6, 20, 5},
+	)))
+
+	want := `[{"HourStart":8,"HourEnd":18,"TableParallelism":1,"SegmentRetries":1},{"HourStart":22,"HourEnd":6,"TableParallelism":20,"SegmentRetries":5}]`
+	if got := string(payload["ScheduleOverrides"]); got != want {
+		t.Errorf("ScheduleOverrides = %s, want %s", got, want)
+	}
+}
+
+func TestAdaptiveRepairCreateWithoutScheduleOverrides(t *testing.T) {
+	payload := createAdaptiveRepair(t, testAdaptiveRepairData(types.ListNull(types.ObjectType{AttrTypes: scheduleOverrideAttrTypes})))
+
+	if got, ok := payload["ScheduleOverrides"]; ok {
+		t.Errorf("ScheduleOverrides = %s, want it left out", got)
+	}
+}
+
+func TestFlattenScheduleOverridesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	list := testScheduleOverrides([4]int64{22, 6, 20, 5})
+
+	overrides, diags := buildScheduleOverrides(ctx, list)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	flattened, diags := flattenScheduleOverrides(ctx, overrides)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !flattened.Equal(list) {
+		t.Errorf("flattened overrides = %s, want %s", flattened, list)
+	}
+}
+
+func TestAdaptiveRepairValidateConfigScheduleOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides types.List
+		wantError bool
+	}{
+		{"separate windows", testScheduleOverrides([4]int64{8, 18, 1, 1}, [4]int64{18, 8, 20, 3}), false},
+		{"overlapping windows", testScheduleOverrides([4]int64{8, 18, 1, 1}, [4]int64{17, 20, 20, 3}), true},
+		{"window wrapping past midnight overlaps", testScheduleOverrides([4]int64{22, 6, 1, 1}, [4]int64{5, 7, 20, 3}), true},
+		{"hour out of range", testScheduleOverrides([4]int64{8, 24, 1, 1}), true},
+		{"empty window", testScheduleOverrides([4]int64{8, 8, 1, 1}), true},
+		{"unknown list", types.ListUnknown(types.ObjectType{AttrTypes: scheduleOverrideAttrTypes}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &cassandraAdaptiveRepairResource{}
+			s := resourceSchema(t, r)
+			data := testAdaptiveRepairData(tt.overrides)
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: newTestConfig(t, s, &data)}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("error = %v, want error: %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestAdaptiveRepairCreateSendsMaxConcurrentRepairs(t *testing.T) {
+	data := testAdaptiveRepairData(types.ListNull(types.ObjectType{AttrTypes: scheduleOverrideAttrTypes}))
+	data.MaxConcurrentRepairs = types.Int64Value(3)
+
+	payload := createAdaptiveRepair(t, data)
+	if got := string(payload["MaxConcurrentRepairs"]); got != "3" {
+		t.Errorf("MaxConcurrentRepairs = %s, want 3", got)
+	}
+}
+
+func TestAdaptiveRepairCreateWithoutMaxConcurrentRepairs(t *testing.T) {
+	payload := createAdaptiveRepair(t, testAdaptiveRepairData(types.ListNull(types.ObjectType{AttrTypes: scheduleOverrideAttrTypes})))
+
+	// 0 leaves the limit to the server
+	if got, ok := payload["MaxConcurrentRepairs"]; ok {
+		t.Errorf("MaxConcurrentRepairs = %s, want it left out", got)
+	}
+}
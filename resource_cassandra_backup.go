@@ -3,12 +3,17 @@ package main
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	axonopsClient "terraform-provider-axonops/client"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -89,7 +95,10 @@ func (r *cassandraBackupResource) Schema(ctx context.Context, req resource.Schem
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("0 1 * * *"),
-				Description: "Cron expression for backup schedule. Default: 0 1 * * *",
+				Description: "Cron expression for backup schedule, with 5 fields: minute hour day-of-month month day-of-week (no seconds). Default: 0 1 * * * (01:00 every day)",
+				Validators: []validator.String{
+					cronExprValidator{},
+				},
 			},
 			"local_retention": schema.StringAttribute{
 				Optional:    true,
@@ -120,7 +129,7 @@ func (r *cassandraBackupResource) Schema(ctx context.Context, req resource.Schem
 			"remote_config": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
-				Description: "Remote storage configuration as key=value pairs separated by newlines.",
+				Description: "Remote storage configuration as key=value pairs separated by newlines. It may hold credentials such as secret keys, which are stored in the Terraform state. The value isn't refreshed from AxonOps, so changes made outside Terraform aren't detected, and it isn't imported: set it in the configuration after importing a remote backup. Add remote_config to ignore_changes if it is managed elsewhere.",
 			},
 			"timeout": schema.StringAttribute{
 				Optional:    true,
@@ -167,6 +176,44 @@ func (r *cassandraBackupResource) Schema(ctx context.Context, req resource.Schem
 				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
 				Description: "Specific node IDs to backup. Empty means all nodes.",
 			},
+			"all_tables": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the backup covers all tables of the selected keyspaces.",
+			},
+			"all_nodes": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the backup runs on all nodes of the selected datacenters.",
+			},
+			"run_verification_snapshot": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Take a one-off snapshot with the same parameters after creating or updating the schedule and fail if it does not succeed. The snapshot is tagged <tag>-verify and stays in the backup catalog, AxonOps has no API to delete a single snapshot. Default: false",
+			},
+			"verification_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("30m"),
+				Description: "How long to wait for the verification snapshot to complete (Go duration, e.g. 30m, 2h). Default: 30m",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"verify_interval": schema.StringAttribute{
+				Optional:    true,
+				Description: "Cron expression for periodic restore verification of this backup, with 5 fields like schedule_expr. Requires verify_datacenter.",
+				Validators: []validator.String{
+					cronExprValidator{},
+					stringvalidator.AlsoRequires(path.MatchRoot("verify_datacenter")),
+				},
+			},
+			"verify_datacenter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Datacenter on which restore verification runs.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("verify_interval")),
+				},
+			},
 		},
 	}
 }
@@ -192,6 +239,101 @@ type cassandraBackupResourceData struct {
 	Keyspaces       types.List   `tfsdk:"keyspaces"`
 	Tables          types.List   `tfsdk:"tables"`
 	Nodes           types.List   `tfsdk:"nodes"`
+	AllTables       types.Bool   `tfsdk:"all_tables"`
+	AllNodes        types.Bool   `tfsdk:"all_nodes"`
+
+	RunVerificationSnapshot types.Bool   `tfsdk:"run_verification_snapshot"`
+	VerificationTimeout     types.String `tfsdk:"verification_timeout"`
+
+	VerifyInterval   types.String `tfsdk:"verify_interval"`
+	VerifyDatacenter types.String `tfsdk:"verify_datacenter"`
+}
+
+// disableVerificationSchedule turns off restore verification for the backup if it was enabled
+func (r *cassandraBackupResource) disableVerificationSchedule(ctx context.Context, data *cassandraBackupResourceData) error {
+	if data.VerifyInterval.IsNull() {
+		return nil
+	}
+
+	return r.client.SetBackupVerificationSchedule(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), axonopsClient.BackupVerificationSchedule{
+		BackupID:     data.ID.ValueString(),
+		Datacenter:   data.VerifyDatacenter.ValueString(),
+		ScheduleExpr: data.VerifyInterval.ValueString(),
+		Active:       false,
+	})
+}
+
+// setVerificationSchedule enables restore verification for the backup when verify_interval is set
+func (r *cassandraBackupResource) setVerificationSchedule(ctx context.Context, data *cassandraBackupResourceData) error {
+	if data.VerifyInterval.IsNull() {
+		return nil
+	}
+
+	return r.client.SetBackupVerificationSchedule(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), axonopsClient.BackupVerificationSchedule{
+		BackupID:     data.ID.ValueString(),
+		Datacenter:   data.VerifyDatacenter.ValueString(),
+		ScheduleExpr: data.VerifyInterval.ValueString(),
+		Active:       true,
+	})
+}
+
+// rollbackBackup removes a backup schedule that was just created when a later step failed,
+// so it isn't left behind untracked
+func (r *cassandraBackupResource) rollbackBackup(ctx context.Context, data *cassandraBackupResourceData, diags *diag.Diagnostics) {
+	err := r.client.DeleteCassandraBackup(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), []string{data.ID.ValueString()})
+	if err != nil {
+		diags.AddWarning("Cleanup Error", fmt.Sprintf("Unable to remove backup schedule %s, it should be removed manually: %s", data.ID.ValueString(), err))
+	}
+}
+
+// verificationPollInterval is how often the verification snapshot status is polled
+const verificationPollInterval = 10 * time.Second
+
+// runVerificationSnapshot takes a one-off snapshot with the schedule's parameters and
+// waits for it to finish, returning an error if it fails or does not finish in time.
+// The snapshot itself is kept, there is no endpoint to delete a single snapshot.
+func (r *cassandraBackupResource) runVerificationSnapshot(ctx context.Context, data *cassandraBackupResourceData, backup axonopsClient.CassandraBackup) error {
+	timeout, err := time.ParseDuration(data.VerificationTimeout.ValueString())
+	if err != nil {
+		return fmt.Errorf("invalid verification_timeout %q: %w", data.VerificationTimeout.ValueString(), err)
+	}
+
+	backup.ID = uuid.New().String()
+	backup.Tag = data.Tag.ValueString() + "-verify"
+
+	err = r.client.TriggerImmediateBackup(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), backup)
+	if err != nil {
+		return fmt.Errorf("unable to trigger verification snapshot: %w", err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting up to %s for verification snapshot %s", timeout, backup.ID))
+
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := r.client.GetCassandraBackupStatus(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), backup.ID)
+		if err != nil {
+			return fmt.Errorf("unable to read verification snapshot status: %w", err)
+		}
+
+		if status != nil {
+			switch strings.ToLower(status.Status) {
+			case "success", "completed", "done":
+				return nil
+			case "failed", "error":
+				return fmt.Errorf("verification snapshot failed: %s", status.Error)
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("verification snapshot did not complete within %s", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(verificationPollInterval):
+		}
+	}
 }
 
 func (r *cassandraBackupResource) buildBackup(ctx context.Context, data *cassandraBackupResourceData, resp *resource.CreateResponse) *axonopsClient.CassandraBackup {
@@ -248,6 +390,173 @@ func (r *cassandraBackupResource) buildBackup(ctx context.Context, data *cassand
 	return backup
 }
 
+// backupTablesAndNodes returns the tables and nodes of a backup as configured. The API
+// may list tables or nodes for a backup of all of them, which were sent as empty lists.
+func backupTablesAndNodes(backup *axonopsClient.CassandraBackup) ([]string, []string) {
+	tables := backup.Tables
+	if tables == nil || backup.AllTables {
+		tables = []string{}
+	}
+
+	nodes := backup.Nodes
+	if nodes == nil || backup.AllNodes {
+		nodes = []string{}
+	}
+
+	return tables, nodes
+}
+
+// findBackup returns the backup with the given ID or, if none has it, the first backup
+// with the tag. An update creates the new schedule before deleting the old one, so two
+// backups may share a tag when that delete failed.
+func findBackup(backups []axonopsClient.CassandraBackup, id, tag string) *axonopsClient.CassandraBackup {
+	for i := range backups {
+		if backups[i].ID == id {
+			return &backups[i]
+		}
+	}
+	for i := range backups {
+		if backups[i].Tag == tag {
+			return &backups[i]
+		}
+	}
+	return nil
+}
+
+// cronField is the range and names accepted by one field of a cron expression
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+	anyDay   bool // accepts ? as well as *
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31, anyDay: true},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 6, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}, anyDay: true},
+}
+
+// normalizeCronExpr collapses the whitespace between the fields of a cron expression
+func normalizeCronExpr(expr string) string {
+	return strings.Join(strings.Fields(expr), " ")
+}
+
+// parseCronExpr checks a 5-field cron expression without seconds, e.g. "0 1 * * *", as AxonOps expects
+func parseCronExpr(expr string) error {
+	fields := strings.Fields(expr)
+	if len(fields) == len(cronFields)+1 {
+		return fmt.Errorf("expected %d fields (minute hour day-of-month month day-of-week) but got %d, AxonOps doesn't take the seconds field of Quartz cron expressions: use e.g. \"0 1 * * *\" for 01:00 every day", len(cronFields), len(fields))
+	}
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected %d fields (minute hour day-of-month month day-of-week) but got %d: use e.g. \"0 1 * * *\" for 01:00 every day", len(cronFields), len(fields))
+	}
+
+	for i, field := range fields {
+		for _, item := range strings.Split(field, ",") {
+			if err := cronFields[i].parseItem(item); err != nil {
+				return fmt.Errorf("invalid %s %q: %w", cronFields[i].name, field, err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseItem checks one comma-separated item of a field: *, a value or a range, with an optional /step
+func (f cronField) parseItem(item string) error {
+	rangePart, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 {
+			return fmt.Errorf("step %q must be a positive number", step)
+		}
+	}
+
+	if rangePart == "*" || (rangePart == "?" && f.anyDay) {
+		return nil
+	}
+
+	low, high, isRange := strings.Cut(rangePart, "-")
+	start, err := f.parseValue(low)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+
+	end, err := f.parseValue(high)
+	if err != nil {
+		return err
+	}
+	if start > end {
+		return fmt.Errorf("range %s is backwards", rangePart)
+	}
+	return nil
+}
+
+func (f cronField) parseValue(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", value)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%d is out of range %d-%d", n, f.min, f.max)
+	}
+	return n, nil
+}
+
+// cronExprValidator rejects malformed cron expressions at plan time instead of when the API schedules them
+type cronExprValidator struct{}
+
+func (v cronExprValidator) Description(_ context.Context) string {
+	return "value must be a 5-field cron expression without seconds (e.g., 0 1 * * *)"
+}
+
+func (v cronExprValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronExprValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parseCronExpr(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Cron Expression", fmt.Sprintf("%s is not a valid cron expression: %s", req.ConfigValue.ValueString(), err))
+	}
+}
+
+// durationValidator rejects values time.ParseDuration can't read, or that aren't positive
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration (e.g., 30m, 2h)"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", fmt.Sprintf("%s must be a positive duration (e.g., 30m), got: %s", req.Path, req.ConfigValue.ValueString()))
+	}
+}
+
 func (r *cassandraBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data cassandraBackupResourceData
 
@@ -311,12 +620,32 @@ func (r *cassandraBackupResource) Create(ctx context.Context, req resource.Creat
 		backup.RemoteConfig = data.RemoteConfig.ValueString()
 	}
 
-	err := r.client.CreateCassandraBackup(data.ClusterType.ValueString(), data.ClusterName.ValueString(), backup)
+	data.AllTables = types.BoolValue(backup.AllTables)
+	data.AllNodes = types.BoolValue(backup.AllNodes)
+
+	err := r.client.CreateCassandraBackup(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), backup)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup: %s", err))
 		return
 	}
 
+	if data.RunVerificationSnapshot.ValueBool() {
+		err = r.runVerificationSnapshot(ctx, &data, backup)
+		if err != nil {
+			// Don't leave an unverified schedule behind
+			r.rollbackBackup(ctx, &data, &resp.Diagnostics)
+			resp.Diagnostics.AddError("Verification Error", fmt.Sprintf("Backup schedule verification failed: %s", err))
+			return
+		}
+	}
+
+	err = r.setVerificationSchedule(ctx, &data)
+	if err != nil {
+		r.rollbackBackup(ctx, &data, &resp.Diagnostics)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set backup verification schedule: %s", err))
+		return
+	}
+
 	tflog.Info(ctx, "Created Cassandra backup resource")
 
 	diags = resp.State.Set(ctx, &data)
@@ -332,21 +661,13 @@ func (r *cassandraBackupResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	backups, err := r.client.GetCassandraBackups(data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	backups, err := r.client.GetCassandraBackups(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backups: %s", err))
 		return
 	}
 
-	// Find backup by tag
-	var found *axonopsClient.CassandraBackup
-	for _, b := range backups {
-		if b.Tag == data.Tag.ValueString() {
-			found = &b
-			break
-		}
-	}
-
+	found := findBackup(backups, data.ID.ValueString(), data.Tag.ValueString())
 	if found == nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -356,7 +677,10 @@ func (r *cassandraBackupResource) Read(ctx context.Context, req resource.ReadReq
 	data.LocalRetention = types.StringValue(found.LocalRetentionDuration)
 	data.Remote = types.BoolValue(found.Remote)
 	data.Schedule = types.BoolValue(found.Schedule)
-	data.ScheduleExpr = types.StringValue(found.ScheduleExpr)
+	// Keep the configured spacing when the API only reformatted the expression
+	if normalizeCronExpr(found.ScheduleExpr) != normalizeCronExpr(data.ScheduleExpr.ValueString()) {
+		data.ScheduleExpr = types.StringValue(normalizeCronExpr(found.ScheduleExpr))
+	}
 	data.Timeout = types.StringValue(found.Timeout)
 	data.Transfers = types.Int64Value(int64(found.Transfers))
 	data.TpsLimit = types.Int64Value(int64(found.TpsLimit))
@@ -366,7 +690,7 @@ func (r *cassandraBackupResource) Read(ctx context.Context, req resource.ReadReq
 		data.RemoteType = types.StringValue(found.RemoteType)
 		data.RemotePath = types.StringValue(found.RemotePath)
 		data.RemoteRetention = types.StringValue(found.RemoteRetentionDuration)
-		data.RemoteConfig = types.StringValue(found.RemoteConfig)
+		// remote_config is kept from state since the API may redact the credentials in it
 	}
 
 	data.Datacenters, diags = types.ListValueFrom(ctx, types.StringType, found.Datacenters)
@@ -379,19 +703,30 @@ func (r *cassandraBackupResource) Read(ctx context.Context, req resource.ReadReq
 	data.Keyspaces, diags = types.ListValueFrom(ctx, types.StringType, keyspaces)
 	resp.Diagnostics.Append(diags...)
 
-	tables := found.Tables
-	if tables == nil {
-		tables = []string{}
-	}
+	tables, nodes := backupTablesAndNodes(found)
 	data.Tables, diags = types.ListValueFrom(ctx, types.StringType, tables)
 	resp.Diagnostics.Append(diags...)
-
-	nodes := found.Nodes
-	if nodes == nil {
-		nodes = []string{}
-	}
 	data.Nodes, diags = types.ListValueFrom(ctx, types.StringType, nodes)
 	resp.Diagnostics.Append(diags...)
+	data.AllTables = types.BoolValue(found.AllTables)
+	data.AllNodes = types.BoolValue(found.AllNodes)
+
+	// Check whether restore verification is still active
+	if !data.VerifyInterval.IsNull() {
+		verification, err := r.client.GetBackupVerificationSchedule(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup verification schedule: %s", err))
+			return
+		}
+
+		if verification == nil || !verification.Active {
+			data.VerifyInterval = types.StringNull()
+			data.VerifyDatacenter = types.StringNull()
+		} else {
+			data.VerifyInterval = types.StringValue(verification.ScheduleExpr)
+			data.VerifyDatacenter = types.StringValue(verification.Datacenter)
+		}
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -409,14 +744,8 @@ func (r *cassandraBackupResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// Delete the old backup
-	err := r.client.DeleteCassandraBackup(stateData.ClusterType.ValueString(), stateData.ClusterName.ValueString(), []string{stateData.ID.ValueString()})
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete old backup for update: %s", err))
-		return
-	}
-
-	// Create new backup with new ID
+	// Create the new backup before removing the old one so a failed create
+	// leaves the existing schedule in place
 	newID := uuid.New().String()
 	planData.ID = types.StringValue(newID)
 
@@ -470,12 +799,44 @@ func (r *cassandraBackupResource) Update(ctx context.Context, req resource.Updat
 		backup.RemoteConfig = planData.RemoteConfig.ValueString()
 	}
 
-	err = r.client.CreateCassandraBackup(planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), backup)
+	planData.AllTables = types.BoolValue(backup.AllTables)
+	planData.AllNodes = types.BoolValue(backup.AllNodes)
+
+	err := r.client.CreateCassandraBackup(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), backup)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create updated backup: %s", err))
 		return
 	}
 
+	// Leave the old schedule in place until the new one is verified and fully set up
+	if planData.RunVerificationSnapshot.ValueBool() {
+		err = r.runVerificationSnapshot(ctx, &planData, backup)
+		if err != nil {
+			r.rollbackBackup(ctx, &planData, &resp.Diagnostics)
+			resp.Diagnostics.AddError("Verification Error", fmt.Sprintf("Backup schedule verification failed: %s", err))
+			return
+		}
+	}
+
+	err = r.setVerificationSchedule(ctx, &planData)
+	if err != nil {
+		r.rollbackBackup(ctx, &planData, &resp.Diagnostics)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set backup verification schedule: %s", err))
+		return
+	}
+
+	// The updated schedule is in place, so failing to remove the old one is only a warning
+	oldID := stateData.ID.ValueString()
+	err = r.disableVerificationSchedule(ctx, &stateData)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Cleanup Error", fmt.Sprintf("Unable to disable verification schedule of old backup %s: %s", oldID, err))
+	}
+
+	err = r.client.DeleteCassandraBackup(ctx, stateData.ClusterType.ValueString(), stateData.ClusterName.ValueString(), []string{oldID})
+	if err != nil {
+		resp.Diagnostics.AddWarning("Cleanup Error", fmt.Sprintf("Unable to delete old backup %s: %s. Both it and the updated backup %s now exist with tag %s, remove the old one manually.", oldID, err, newID, planData.Tag.ValueString()))
+	}
+
 	tflog.Info(ctx, "Updated Cassandra backup resource")
 
 	diags = resp.State.Set(ctx, &planData)
@@ -491,7 +852,13 @@ func (r *cassandraBackupResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.client.DeleteCassandraBackup(data.ClusterType.ValueString(), data.ClusterName.ValueString(), []string{data.ID.ValueString()})
+	err := r.disableVerificationSchedule(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable backup verification schedule: %s", err))
+		return
+	}
+
+	err = r.client.DeleteCassandraBackup(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), []string{data.ID.ValueString()})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup: %s", err))
 		return
@@ -500,8 +867,40 @@ func (r *cassandraBackupResource) Delete(ctx context.Context, req resource.Delet
 	tflog.Info(ctx, "Deleted Cassandra backup resource")
 }
 
+var importAddressRegex = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// importAll lists the import commands and import blocks for every backup in a cluster.
+// Terraform imports a single resource instance per import ID, so a wildcard import
+// can't create the resources itself; the generated commands are returned instead.
+func (r *cassandraBackupResource) importAll(ctx context.Context, clusterType, clusterName string) (string, error) {
+	backups, err := r.client.GetCassandraBackups(ctx, clusterType, clusterName)
+	if err != nil {
+		return "", err
+	}
+
+	if len(backups) == 0 {
+		return "", fmt.Errorf("no backups found in cluster %s/%s", clusterType, clusterName)
+	}
+
+	var commands, blocks strings.Builder
+	for _, b := range backups {
+		name := importAddressRegex.ReplaceAllString(b.Tag, "_")
+		if name == "" || (name[0] >= '0' && name[0] <= '9') {
+			name = "backup_" + name
+		}
+		id := fmt.Sprintf("%s/%s/%s", clusterType, clusterName, b.Tag)
+
+		fmt.Fprintf(&commands, "terraform import 'axonops_cassandra_backup.%s' '%s'\n", name, id)
+		fmt.Fprintf(&blocks, "import {\n  to = axonops_cassandra_backup.%s\n  id = %q\n}\n\n", name, id)
+	}
+
+	return fmt.Sprintf("Import commands:\n\n%s\nOr, with Terraform 1.5+, add these import blocks and run terraform plan -generate-config-out=backups.tf:\n\n%s", commands.String(), blocks.String()), nil
+}
+
 // ImportState imports an existing backup.
-// Import ID format: cluster_type/cluster_name/tag
+// Import ID format: cluster_type/cluster_name/tag, or cluster_type/cluster_name/id
+// when several backups have the same tag.
+// Use cluster_type/cluster_name/* to list the imports for every backup in the cluster.
 func (r *cassandraBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.Split(req.ID, "/")
 	if len(parts) != 3 {
@@ -516,37 +915,58 @@ func (r *cassandraBackupResource) ImportState(ctx context.Context, req resource.
 	clusterName := parts[1]
 	tag := parts[2]
 
-	backups, err := r.client.GetCassandraBackups(clusterType, clusterName)
-	if err != nil {
-		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read backups: %s", err))
+	if tag == "*" {
+		imports, err := r.importAll(ctx, clusterType, clusterName)
+		if err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to list backups: %s", err))
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Bulk Import",
+			fmt.Sprintf("Terraform can only import one resource per import ID. Run the following to import every backup in cluster %s/%s.\n\n%s", clusterType, clusterName, imports),
+		)
 		return
 	}
 
-	var found *axonopsClient.CassandraBackup
-	for _, b := range backups {
-		if b.Tag == tag {
-			found = &b
-			break
-		}
+	backups, err := r.client.GetCassandraBackups(ctx, clusterType, clusterName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read backups: %s", err))
+		return
 	}
 
+	found := findBackup(backups, tag, tag)
 	if found == nil {
-		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Backup with tag %s not found in cluster %s/%s", tag, clusterType, clusterName))
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Backup with tag or ID %s not found in cluster %s/%s", tag, clusterType, clusterName))
 		return
 	}
 
+	// A tag shared by several backups can't tell them apart
+	if found.ID != tag {
+		var ids []string
+		for _, b := range backups {
+			if b.Tag == tag {
+				ids = append(ids, b.ID)
+			}
+		}
+		if len(ids) > 1 {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Backups %s in cluster %s/%s all have tag %s, import one of them by ID: %s/%s/<id>", strings.Join(ids, ", "), clusterType, clusterName, tag, clusterType, clusterName))
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), found.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag"), found.Tag)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule"), found.Schedule)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_expr"), found.ScheduleExpr)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_expr"), normalizeCronExpr(found.ScheduleExpr))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("local_retention"), found.LocalRetentionDuration)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remote"), found.Remote)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remote_type"), found.RemoteType)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remote_path"), found.RemotePath)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remote_retention"), found.RemoteRetentionDuration)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remote_config"), found.RemoteConfig)...)
+	// remote_config is left null since the API may redact the credentials in it
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), found.Timeout)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("transfers"), int64(found.Transfers))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tps_limit"), int64(found.TpsLimit))...)
@@ -559,17 +979,23 @@ func (r *cassandraBackupResource) ImportState(ctx context.Context, req resource.
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("keyspaces"), keyspaces)...)
 
-	tables := found.Tables
-	if tables == nil {
-		tables = []string{}
-	}
+	tables, nodes := backupTablesAndNodes(found)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tables"), tables)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("nodes"), nodes)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("all_tables"), found.AllTables)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("all_nodes"), found.AllNodes)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("run_verification_snapshot"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verification_timeout"), "30m")...)
 
-	nodes := found.Nodes
-	if nodes == nil {
-		nodes = []string{}
+	verification, err := r.client.GetBackupVerificationSchedule(ctx, clusterType, clusterName, found.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read backup verification schedule: %s", err))
+		return
+	}
+	if verification != nil && verification.Active {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verify_interval"), verification.ScheduleExpr)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("verify_datacenter"), verification.Datacenter)...)
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("nodes"), nodes)...)
 
 	tflog.Info(ctx, fmt.Sprintf("Imported Cassandra backup %s from cluster %s/%s", tag, clusterType, clusterName))
 }
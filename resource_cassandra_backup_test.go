@@ -0,0 +1,459 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeBackupAPI serves the backup endpoints of a cluster and records the calls made
+type fakeBackupAPI struct {
+	t *testing.T
+
+	// Status and error reported for every snapshot run
+	snapshotStatus string
+	snapshotError  string
+
+	// Reject every verification schedule
+	verifyRejected bool
+
+	created       []axonopsClient.CassandraBackup
+	deleted       [][]string
+	verifications []axonopsClient.BackupVerificationSchedule
+}
+
+func (f *fakeBackupAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/cassandraSnapshot/test-org/cassandra/prod":
+		var backup axonopsClient.CassandraBackup
+		if err := json.NewDecoder(r.Body).Decode(&backup); err != nil {
+			f.t.Errorf("invalid backup: %s", err)
+		}
+		f.created = append(f.created, backup)
+
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/cassandraSnapshotStatus/test-org/cassandra/prod/"):
+		json.NewEncoder(w).Encode(axonopsClient.CassandraBackupStatus{
+			ID:     strings.TrimPrefix(r.URL.Path, "/api/v1/cassandraSnapshotStatus/test-org/cassandra/prod/"),
+			Status: f.snapshotStatus,
+			Error:  f.snapshotError,
+		})
+
+	case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/cassandraScheduleSnapshot/test-org/cassandra/prod":
+		var ids []string
+		if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+			f.t.Errorf("invalid backup IDs: %s", err)
+		}
+		f.deleted = append(f.deleted, ids)
+
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/cassandraScheduleSnapshot/test-org/cassandra/prod":
+		var response axonopsClient.CassandraBackupsResponse
+		for _, backup := range f.created {
+			if !backup.Schedule {
+				continue
+			}
+			details, _ := json.Marshal(backup)
+			params, _ := json.Marshal([]axonopsClient.CassandraScheduledParam{{BackupDetails: string(details)}})
+			response.ScheduledSnapshots = append(response.ScheduledSnapshots, axonopsClient.CassandraScheduledSnapshot{ID: backup.ID, Params: params})
+		}
+		json.NewEncoder(w).Encode(response)
+
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/cassandraBackupVerify/test-org/cassandra/prod/"):
+		backupID := strings.TrimPrefix(r.URL.Path, "/api/v1/cassandraBackupVerify/test-org/cassandra/prod/")
+		for i := len(f.verifications) - 1; i >= 0; i-- {
+			if f.verifications[i].BackupID == backupID {
+				json.NewEncoder(w).Encode(f.verifications[i])
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/cassandraBackupVerify/test-org/cassandra/prod":
+		if f.verifyRejected {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var schedule axonopsClient.BackupVerificationSchedule
+		if err := json.NewDecoder(r.Body).Decode(&schedule); err != nil {
+			f.t.Errorf("invalid verification schedule: %s", err)
+		}
+		f.verifications = append(f.verifications, schedule)
+
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func testBackupData() cassandraBackupResourceData {
+	emptyList := types.ListValueMust(types.StringType, []attr.Value{})
+
+	return cassandraBackupResourceData{
+		ClusterName:     types.StringValue("prod"),
+		ClusterType:     types.StringValue("cassandra"),
+		ID:              types.StringUnknown(),
+		Tag:             types.StringValue("daily"),
+		Datacenters:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("dc1")}),
+		Schedule:        types.BoolValue(true),
+		ScheduleExpr:    types.StringValue("0 1 * * *"),
+		LocalRetention:  types.StringValue("10d"),
+		Remote:          types.BoolValue(false),
+		RemoteType:      types.StringNull(),
+		RemotePath:      types.StringNull(),
+		RemoteRetention: types.StringValue("60d"),
+		RemoteConfig:    types.StringNull(),
+		Timeout:         types.StringValue("10h"),
+		Transfers:       types.Int64Value(1),
+		TpsLimit:        types.Int64Value(50),
+		BwLimit:         types.StringValue(""),
+		Keyspaces:       emptyList,
+		Tables:          emptyList,
+		Nodes:           emptyList,
+		AllTables:       types.BoolUnknown(),
+		AllNodes:        types.BoolUnknown(),
+
+		RunVerificationSnapshot: types.BoolValue(false),
+		VerificationTimeout:     types.StringValue("30m"),
+
+		VerifyInterval:   types.StringNull(),
+		VerifyDatacenter: types.StringNull(),
+	}
+}
+
+func createBackup(t *testing.T, api *fakeBackupAPI, data cassandraBackupResourceData) resource.CreateResponse {
+	t.Helper()
+
+	r := &cassandraBackupResource{client: newTestClient(t, api)}
+	s := resourceSchema(t, r)
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: newTestPlan(t, s, &data)}, &resp)
+	return resp
+}
+
+func TestBackupCreateVerificationSnapshotSucceeds(t *testing.T) {
+	api := &fakeBackupAPI{t: t, snapshotStatus: "Success"}
+	data := testBackupData()
+	data.RunVerificationSnapshot = types.BoolValue(true)
+
+	resp := createBackup(t, api, data)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if len(api.created) != 2 {
+		t.Fatalf("created %d backups, want the schedule and the verification snapshot", len(api.created))
+	}
+	schedule, snapshot := api.created[0], api.created[1]
+	if !schedule.Schedule || schedule.Tag != "daily" {
+		t.Errorf("schedule = %+v, want a scheduled backup tagged daily", schedule)
+	}
+	if snapshot.Schedule || snapshot.ScheduleExpr != "" || snapshot.Tag != "daily-verify" || snapshot.ID == schedule.ID {
+		t.Errorf("snapshot = %+v, want a one-off backup tagged daily-verify", snapshot)
+	}
+	if len(api.deleted) != 0 {
+		t.Errorf("deleted backups %v after a successful verification", api.deleted)
+	}
+}
+
+func TestBackupCreateVerificationSnapshotFails(t *testing.T) {
+	api := &fakeBackupAPI{t: t, snapshotStatus: "Failed", snapshotError: "node 10.0.0.1 unreachable"}
+	data := testBackupData()
+	data.RunVerificationSnapshot = types.BoolValue(true)
+
+	resp := createBackup(t, api, data)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the verification snapshot fails")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "node 10.0.0.1 unreachable") {
+		t.Errorf("error does not include the snapshot error: %s", detail)
+	}
+
+	// The unverified schedule is removed again
+	if len(api.created) != 2 || len(api.deleted) != 1 || len(api.deleted[0]) != 1 || api.deleted[0][0] != api.created[0].ID {
+		t.Errorf("created %+v, deleted %v, want the schedule deleted", api.created, api.deleted)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("state was saved for a failed create")
+	}
+}
+
+func TestBackupCreateWithoutVerificationSnapshot(t *testing.T) {
+	api := &fakeBackupAPI{t: t}
+
+	resp := createBackup(t, api, testBackupData())
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if len(api.created) != 1 {
+		t.Errorf("created %d backups, want only the schedule", len(api.created))
+	}
+}
+
+func TestBackupCreateSetsVerificationSchedule(t *testing.T) {
+	api := &fakeBackupAPI{t: t}
+	data := testBackupData()
+	data.VerifyInterval = types.StringValue("0 3 * * 0")
+	data.VerifyDatacenter = types.StringValue("dc2")
+
+	resp := createBackup(t, api, data)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if len(api.created) != 1 || len(api.verifications) != 1 {
+		t.Fatalf("created %d backups and %d verification schedules, want 1 each", len(api.created), len(api.verifications))
+	}
+	want := axonopsClient.BackupVerificationSchedule{
+		BackupID:     api.created[0].ID,
+		Datacenter:   "dc2",
+		ScheduleExpr: "0 3 * * 0",
+		Active:       true,
+	}
+	if api.verifications[0] != want {
+		t.Errorf("verification schedule = %+v, want %+v", api.verifications[0], want)
+	}
+}
+
+func TestBackupCreateWithoutVerificationSchedule(t *testing.T) {
+	api := &fakeBackupAPI{t: t}
+
+	resp := createBackup(t, api, testBackupData())
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if len(api.verifications) != 0 {
+		t.Errorf("verification schedules = %+v, want none", api.verifications)
+	}
+}
+
+func TestBackupReadVerificationSchedule(t *testing.T) {
+	api := &fakeBackupAPI{t: t}
+	data := testBackupData()
+	data.VerifyInterval = types.StringValue("0 3 * * 0")
+	data.VerifyDatacenter = types.StringValue("dc2")
+
+	created := createBackup(t, api, data)
+	if created.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", created.Diagnostics)
+	}
+
+	read := func() cassandraBackupResourceData {
+		t.Helper()
+
+		r := &cassandraBackupResource{client: newTestClient(t, api)}
+		resp := resource.ReadResponse{State: created.State}
+		r.Read(context.Background(), resource.ReadRequest{State: created.State}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+
+		var state cassandraBackupResourceData
+		if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+			t.Fatalf("unable to read state: %v", diags)
+		}
+		return state
+	}
+
+	state := read()
+	if state.VerifyInterval.ValueString() != "0 3 * * 0" || state.VerifyDatacenter.ValueString() != "dc2" {
+		t.Errorf("verify_interval = %s, verify_datacenter = %s, want the active schedule", state.VerifyInterval, state.VerifyDatacenter)
+	}
+
+	// Verification turned off outside of Terraform
+	api.verifications[0].Active = false
+	state = read()
+	if !state.VerifyInterval.IsNull() || !state.VerifyDatacenter.IsNull() {
+		t.Errorf("verify_interval = %s, verify_datacenter = %s, want null for an inactive schedule", state.VerifyInterval, state.VerifyDatacenter)
+	}
+}
+
+func updateBackup(t *testing.T, api *fakeBackupAPI, planned cassandraBackupResourceData) resource.UpdateResponse {
+	t.Helper()
+
+	r := &cassandraBackupResource{client: newTestClient(t, api)}
+	s := resourceSchema(t, r)
+
+	prior := testBackupData()
+	prior.ID = types.StringValue("old")
+	prior.AllTables = types.BoolValue(true)
+	prior.AllNodes = types.BoolValue(true)
+
+	resp := resource.UpdateResponse{State: newTestState(t, s, &prior)}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  newTestPlan(t, s, &planned),
+		State: newTestState(t, s, &prior),
+	}, &resp)
+	return resp
+}
+
+func TestBackupUpdateVerificationSnapshotSucceeds(t *testing.T) {
+	api := &fakeBackupAPI{t: t, snapshotStatus: "Success"}
+	planned := testBackupData()
+	planned.ScheduleExpr = types.StringValue("0 2 * * *")
+	planned.RunVerificationSnapshot = types.BoolValue(true)
+
+	resp := updateBackup(t, api, planned)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if len(api.created) != 2 || api.created[1].Tag != "daily-verify" {
+		t.Fatalf("created %+v, want the new schedule and the verification snapshot", api.created)
+	}
+	if len(api.deleted) != 1 || len(api.deleted[0]) != 1 || api.deleted[0][0] != "old" {
+		t.Errorf("deleted %v, want the old schedule", api.deleted)
+	}
+}
+
+func TestBackupUpdateVerificationSnapshotFails(t *testing.T) {
+	api := &fakeBackupAPI{t: t, snapshotStatus: "Failed", snapshotError: "node 10.0.0.1 unreachable"}
+	planned := testBackupData()
+	planned.ScheduleExpr = types.StringValue("0 2 * * *")
+	planned.RunVerificationSnapshot = types.BoolValue(true)
+
+	resp := updateBackup(t, api, planned)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the verification snapshot fails")
+	}
+
+	// The new schedule is removed and the old one kept
+	if len(api.created) != 2 || len(api.deleted) != 1 || len(api.deleted[0]) != 1 || api.deleted[0][0] != api.created[0].ID {
+		t.Errorf("created %+v, deleted %v, want only the new schedule deleted", api.created, api.deleted)
+	}
+}
+
+func TestBackupCreateVerificationScheduleFails(t *testing.T) {
+	api := &fakeBackupAPI{t: t, verifyRejected: true}
+	data := testBackupData()
+	data.VerifyInterval = types.StringValue("0 3 * * 0")
+	data.VerifyDatacenter = types.StringValue("dc2")
+
+	resp := createBackup(t, api, data)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the verification schedule is rejected")
+	}
+
+	// The new schedule isn't left behind untracked
+	if len(api.created) != 1 || len(api.deleted) != 1 || len(api.deleted[0]) != 1 || api.deleted[0][0] != api.created[0].ID {
+		t.Errorf("created %+v, deleted %v, want the schedule deleted", api.created, api.deleted)
+	}
+}
+
+func TestBackupUpdateVerificationScheduleFails(t *testing.T) {
+	api := &fakeBackupAPI{t: t, verifyRejected: true}
+	planned := testBackupData()
+	planned.VerifyInterval = types.StringValue("0 3 * * 0")
+	planned.VerifyDatacenter = types.StringValue("dc2")
+
+	resp := updateBackup(t, api, planned)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error when the verification schedule is rejected")
+	}
+
+	// The new schedule is removed and the old one kept
+	if len(api.created) != 1 || len(api.deleted) != 1 || len(api.deleted[0]) != 1 || api.deleted[0][0] != api.created[0].ID {
+		t.Errorf("created %+v, deleted %v, want only the new schedule deleted", api.created, api.deleted)
+	}
+}
+
+func TestBackupImport(t *testing.T) {
+	api := &fakeBackupAPI{t: t}
+	api.created = []axonopsClient.CassandraBackup{{ID: "b-1", Tag: "daily", Schedule: true, ScheduleExpr: "0 1 * * *", Datacenters: []string{"dc1"},
+		Remote: true, RemoteType: "s3", RemotePath: "bucket/backups", RemoteConfig: "secret_access_key = ******"}}
+	api.verifications = []axonopsClient.BackupVerificationSchedule{{BackupID: "b-1", Datacenter: "dc2", ScheduleExpr: "0 3 * * 0", Active: true}}
+
+	r := &cassandraBackupResource{client: newTestClient(t, api)}
+	s := resourceSchema(t, r)
+
+	resp := resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}}
+	r.ImportState(context.Background(), resource.ImportStateRequest{ID: "cassandra/prod/daily"}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state cassandraBackupResourceData
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+	if state.VerifyInterval.ValueString() != "0 3 * * 0" || state.VerifyDatacenter.ValueString() != "dc2" {
+		t.Errorf("verify_interval = %s, verify_datacenter = %s, want the active schedule", state.VerifyInterval, state.VerifyDatacenter)
+	}
+
+	// The API may redact remote_config, so it is left to the configuration
+	if !state.RemoteConfig.IsNull() {
+		t.Errorf("remote_config = %s, want null", state.RemoteConfig)
+	}
+}
+
+func TestFindBackup(t *testing.T) {
+	// The old schedule is left behind when an update failed to delete it
+	backups := []axonopsClient.CassandraBackup{
+		{ID: "old", Tag: "daily"},
+		{ID: "new", Tag: "daily"},
+		{ID: "other", Tag: "weekly"},
+	}
+
+	tests := []struct {
+		name   string
+		id     string
+		tag    string
+		wantID string
+	}{
+		{"matches ID before tag", "new", "daily", "new"},
+		{"falls back to tag", "gone", "weekly", "other"},
+		{"not found", "gone", "hourly", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := findBackup(backups, tt.id, tt.tag)
+			if tt.wantID == "" {
+				if found != nil {
+					t.Errorf("found %+v, want none", found)
+				}
+				return
+			}
+			if found == nil || found.ID != tt.wantID {
+				t.Errorf("found %+v, want backup %s", found, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	tests := []struct {
+		value     types.String
+		wantError bool
+	}{
+		{types.StringValue("30m"), false},
+		{types.StringValue("1h30m"), false},
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+		{types.StringValue("30"), true},
+		{types.StringValue("thirty minutes"), true},
+		{types.StringValue("0s"), true},
+		{types.StringValue("-5m"), true},
+	}
+
+	for _, tt := range tests {
+		req := validator.StringRequest{Path: path.Root("verification_timeout"), ConfigValue: tt.value}
+		var resp validator.StringResponse
+		durationValidator{}.ValidateString(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() != tt.wantError {
+			t.Errorf("%s: error = %v, want error: %v", tt.value, resp.Diagnostics, tt.wantError)
+		}
+	}
+}
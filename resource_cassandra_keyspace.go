@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*cassandraKeyspaceResource)(nil)
+var _ resource.ResourceWithImportState = (*cassandraKeyspaceResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*cassandraKeyspaceResource)(nil)
+
+// cassandraReplicationStrategyPrefix is the package Cassandra reports replication strategies under
+const cassandraReplicationStrategyPrefix = "org.apache.cassandra.locator."
+
+type cassandraKeyspaceResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewCassandraKeyspaceResource() resource.Resource {
+	return &cassandraKeyspaceResource{}
+}
+
+func (r *cassandraKeyspaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *cassandraKeyspaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cassandra_keyspace"
+}
+
+func (r *cassandraKeyspaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// A keyspace can't be renamed or moved, so those changes recreate it
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Cassandra keyspace and its replication settings. Destroying the resource drops the keyspace and all of its data.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the cluster.",
+				PlanModifiers: replaceString,
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("cassandra"),
+				Description:   "The cluster type (cassandra or dse). Default: cassandra",
+				PlanModifiers: replaceString,
+				Validators: []validator.String{
+					stringvalidator.OneOf("cassandra", "dse"),
+				},
+			},
+			"keyspace_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the keyspace.",
+				PlanModifiers: replaceString,
+			},
+			"replication_strategy": schema.StringAttribute{
+				Required:    true,
+				Description: "The replication strategy: SimpleStrategy or NetworkTopologyStrategy.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("SimpleStrategy", "NetworkTopologyStrategy"),
+				},
+			},
+			"replication_options": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Replication factor per datacenter for NetworkTopologyStrategy (e.g., { dc1 = \"3\" }), or { replication_factor = \"3\" } for SimpleStrategy.",
+			},
+		},
+	}
+}
+
+type cassandraKeyspaceResourceData struct {
+	ClusterName         types.String `tfsdk:"cluster_name"`
+	ClusterType         types.String `tfsdk:"cluster_type"`
+	KeyspaceName        types.String `tfsdk:"keyspace_name"`
+	ReplicationStrategy types.String `tfsdk:"replication_strategy"`
+	ReplicationOptions  types.Map    `tfsdk:"replication_options"`
+}
+
+func (r *cassandraKeyspaceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data cassandraKeyspaceResourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ReplicationStrategy.IsUnknown() || data.ReplicationOptions.IsNull() || data.ReplicationOptions.IsUnknown() {
+		return
+	}
+
+	var options map[string]types.String
+	resp.Diagnostics.Append(data.ReplicationOptions.ElementsAs(ctx, &options, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key, value := range options {
+		if value.IsUnknown() {
+			continue
+		}
+		if factor, err := strconv.Atoi(value.ValueString()); err != nil || factor < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("replication_options"),
+				"Invalid Replication Factor",
+				fmt.Sprintf("Replication factor for %s must be a non-negative integer, got: %s", key, value.ValueString()),
+			)
+		}
+	}
+
+	_, hasFactor := options["replication_factor"]
+	switch data.ReplicationStrategy.ValueString() {
+	case "SimpleStrategy":
+		if !hasFactor || len(options) != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("replication_options"),
+				"Invalid Replication Options",
+				"SimpleStrategy takes a single replication_factor option.",
+			)
+		}
+	case "NetworkTopologyStrategy":
+		if hasFactor || len(options) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("replication_options"),
+				"Invalid Replication Options",
+				"NetworkTopologyStrategy takes a replication factor per datacenter.",
+			)
+		}
+	}
+}
+
+// buildKeyspace converts the resource data to the API representation
+func (d *cassandraKeyspaceResourceData) buildKeyspace(ctx context.Context) (axonopsClient.CassandraKeyspace, diag.Diagnostics) {
+	options := make(map[string]string)
+	diags := d.ReplicationOptions.ElementsAs(ctx, &options, false)
+
+	return axonopsClient.CassandraKeyspace{
+		Name:                d.KeyspaceName.ValueString(),
+		ReplicationStrategy: d.ReplicationStrategy.ValueString(),
+		ReplicationOptions:  options,
+	}, diags
+}
+
+// flattenKeyspace sets the replication settings from the API keyspace
+func (d *cassandraKeyspaceResourceData) flattenKeyspace(ctx context.Context, keyspace *axonopsClient.CassandraKeyspace) diag.Diagnostics {
+	d.ReplicationStrategy = types.StringValue(strings.TrimPrefix(keyspace.ReplicationStrategy, cassandraReplicationStrategyPrefix))
+
+	// Cassandra reports the strategy class as an option as well
+	options := make(map[string]string)
+	for key, value := range keyspace.ReplicationOptions {
+		if key != "class" {
+			options[key] = value
+		}
+	}
+
+	var diags diag.Diagnostics
+	d.ReplicationOptions, diags = types.MapValueFrom(ctx, types.StringType, options)
+	return diags
+}
+
+func (r *cassandraKeyspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cassandraKeyspaceResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyspace, diags := data.buildKeyspace(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateKeyspace(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), keyspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create keyspace: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Created cassandra keyspace resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cassandraKeyspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cassandraKeyspaceResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyspace, err := r.client.GetKeyspace(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.KeyspaceName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read keyspace: %s", err))
+		return
+	}
+
+	if keyspace == nil {
+		// Keyspace was dropped outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.flattenKeyspace(ctx, keyspace)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cassandraKeyspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data cassandraKeyspaceResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyspace, diags := data.buildKeyspace(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateKeyspace(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), keyspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update keyspace: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Updated cassandra keyspace resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cassandraKeyspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data cassandraKeyspaceResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteKeyspace(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.KeyspaceName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete keyspace: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted cassandra keyspace resource")
+}
+
+// ImportState imports an existing keyspace.
+// Import ID format: cluster_type/cluster_name/keyspace_name
+func (r *cassandraKeyspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/keyspace_name, got: %s", req.ID),
+		)
+		return
+	}
+
+	clusterType := parts[0]
+	clusterName := parts[1]
+	keyspaceName := parts[2]
+
+	keyspace, err := r.client.GetKeyspace(ctx, clusterType, clusterName, keyspaceName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read keyspace: %s", err))
+		return
+	}
+
+	if keyspace == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Keyspace %s not found in cluster %s/%s", keyspaceName, clusterType, clusterName))
+		return
+	}
+
+	data := cassandraKeyspaceResourceData{
+		ClusterName:  types.StringValue(clusterName),
+		ClusterType:  types.StringValue(clusterType),
+		KeyspaceName: types.StringValue(keyspaceName),
+	}
+	resp.Diagnostics.Append(data.flattenKeyspace(ctx, keyspace)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported keyspace %s from cluster %s/%s", keyspaceName, clusterType, clusterName))
+}
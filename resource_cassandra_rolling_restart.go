@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*cassandraRollingRestartResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*cassandraRollingRestartResource)(nil)
+
+type cassandraRollingRestartResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewCassandraRollingRestartResource() resource.Resource {
+	return &cassandraRollingRestartResource{}
+}
+
+func (r *cassandraRollingRestartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *cassandraRollingRestartResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cassandra_rolling_restart"
+}
+
+func (r *cassandraRollingRestartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// A restart can't be changed once started, so every change starts a new one
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Description: "Starts a rolling restart of the nodes of a Cassandra cluster when created, restarting one node at a time. Change triggers to restart again. Destroying the resource does not stop a restart in progress.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the cluster.",
+				PlanModifiers: replaceString,
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("cassandra"),
+				Description:   "The cluster type (cassandra or dse). Default: cassandra",
+				PlanModifiers: replaceString,
+				Validators: []validator.String{
+					stringvalidator.OneOf("cassandra", "dse"),
+				},
+			},
+			"datacenter": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Only restart the nodes of this datacenter. Default: all datacenters",
+				PlanModifiers: replaceString,
+			},
+			"wait_for_schema_agreement": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Wait for the schema to agree across the cluster before restarting the next node. Default: true",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"inter_node_delay": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("60s"),
+				Description:   "How long to wait after a node is back up before restarting the next one (Go duration, e.g. 60s, 5m). Default: 60s",
+				PlanModifiers: replaceString,
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary values that restart the cluster again when any of them changes, e.g. { config_version = \"42\" }.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "in_progress while the rolling restart is running, otherwise idle.",
+			},
+		},
+	}
+}
+
+type cassandraRollingRestartResourceData struct {
+	ClusterName            types.String `tfsdk:"cluster_name"`
+	ClusterType            types.String `tfsdk:"cluster_type"`
+	Datacenter             types.String `tfsdk:"datacenter"`
+	WaitForSchemaAgreement types.Bool   `tfsdk:"wait_for_schema_agreement"`
+	InterNodeDelay         types.String `tfsdk:"inter_node_delay"`
+	Triggers               types.Map    `tfsdk:"triggers"`
+	Status                 types.String `tfsdk:"status"`
+}
+
+func (r *cassandraRollingRestartResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var interNodeDelay types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("inter_node_delay"), &interNodeDelay)...)
+	if resp.Diagnostics.HasError() || interNodeDelay.IsNull() || interNodeDelay.IsUnknown() {
+		return
+	}
+
+	if _, err := time.ParseDuration(interNodeDelay.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("inter_node_delay"), "Invalid Duration", fmt.Sprintf("inter_node_delay must be a duration (e.g., 60s), got: %s", interNodeDelay.ValueString()))
+	}
+}
+
+// refreshStatus reads whether a rolling restart is running on the cluster
+func (r *cassandraRollingRestartResource) refreshStatus(ctx context.Context, data *cassandraRollingRestartResourceData) error {
+	status, err := r.client.GetRollingRestartStatus(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		return err
+	}
+
+	if status.InProgress {
+		data.Status = types.StringValue("in_progress")
+	} else {
+		data.Status = types.StringValue("idle")
+	}
+	return nil
+}
+
+func (r *cassandraRollingRestartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cassandraRollingRestartResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.InitiateRollingRestart(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), axonopsClient.RollingRestartRequest{
+		WaitForSchemaAgreement: data.WaitForSchemaAgreement.ValueBool(),
+		InterNodeDelay:         data.InterNodeDelay.ValueString(),
+		Datacenter:             data.Datacenter.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to start rolling restart: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Started rolling restart of cluster %s/%s", data.ClusterType.ValueString(), data.ClusterName.ValueString()))
+
+	err = r.refreshStatus(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Rolling restart was started but its status could not be read: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cassandraRollingRestartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cassandraRollingRestartResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refreshStatus(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rolling restart status: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cassandraRollingRestartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data cassandraRollingRestartResourceData
+
+	// Every configurable attribute requires replacement, so only computed values can change here
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refreshStatus(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rolling restart status: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cassandraRollingRestartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A restart can't be undone, a restart in progress keeps running
+	tflog.Info(ctx, "Removed Cassandra rolling restart resource from state, the cluster is unchanged")
+}
@@ -0,0 +1,357 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*cassandraSnapshotResource)(nil)
+
+type cassandraSnapshotResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewCassandraSnapshotResource() resource.Resource {
+	return &cassandraSnapshotResource{}
+}
+
+func (r *cassandraSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *cassandraSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cassandra_snapshot"
+}
+
+func (r *cassandraSnapshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// A snapshot can't be changed once taken, so every change takes a new one
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	replaceList := []planmodifier.List{listplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Description: "Takes an on-demand Cassandra snapshot when created. Change triggers to take a new one. Destroying the resource does not remove the snapshot.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the cluster.",
+				PlanModifiers: replaceString,
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("cassandra"),
+				Description:   "The cluster type (cassandra or dse). Default: cassandra",
+				PlanModifiers: replaceString,
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for the snapshot (auto-generated).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Required:      true,
+				Description:   "Name/tag for the snapshot.",
+				PlanModifiers: replaceString,
+			},
+			"datacenters": schema.ListAttribute{
+				ElementType:   types.StringType,
+				Required:      true,
+				Description:   "List of datacenters to snapshot.",
+				PlanModifiers: replaceList,
+			},
+			"keyspaces": schema.ListAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Default:       listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Description:   "Keyspaces to snapshot. Empty means all keyspaces.",
+				PlanModifiers: replaceList,
+			},
+			"tables": schema.ListAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Default:       listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Description:   "Tables to snapshot (format: keyspace.table). Empty means all tables.",
+				PlanModifiers: replaceList,
+			},
+			"nodes": schema.ListAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				Computed:      true,
+				Default:       listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Description:   "Specific node IDs to snapshot. Empty means all nodes.",
+				PlanModifiers: replaceList,
+			},
+			"local_retention": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("10d"),
+				Description:   "Local snapshot retention duration. Default: 10d",
+				PlanModifiers: replaceString,
+			},
+			"remote": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to upload the snapshot to remote storage. Default: false",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_type": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Remote storage type: s3, sftp, azure.",
+				PlanModifiers: replaceString,
+			},
+			"remote_path": schema.StringAttribute{
+				Optional:      true,
+				Description:   "Path on the remote storage.",
+				PlanModifiers: replaceString,
+			},
+			"remote_retention": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("60d"),
+				Description:   "Remote snapshot retention duration. Default: 60d",
+				PlanModifiers: replaceString,
+			},
+			"remote_config": schema.StringAttribute{
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Remote storage configuration as key=value pairs separated by newlines.",
+				PlanModifiers: replaceString,
+			},
+			"timeout": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("10h"),
+				Description:   "Snapshot operation timeout. Default: 10h",
+				PlanModifiers: replaceString,
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary values that take a new snapshot when any of them changes, e.g. { timestamp = timestamp() }.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the snapshot run as last reported by AxonOps, empty while unknown.",
+			},
+			"exists": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether AxonOps still knows about the snapshot, either in the backup catalog or by its run status.",
+			},
+		},
+	}
+}
+
+type cassandraSnapshotResourceData struct {
+	ClusterName     types.String `tfsdk:"cluster_name"`
+	ClusterType     types.String `tfsdk:"cluster_type"`
+	ID              types.String `tfsdk:"id"`
+	Tag             types.String `tfsdk:"tag"`
+	Datacenters     types.List   `tfsdk:"datacenters"`
+	Keyspaces       types.List   `tfsdk:"keyspaces"`
+	Tables          types.List   `tfsdk:"tables"`
+	Nodes           types.List   `tfsdk:"nodes"`
+	LocalRetention  types.String `tfsdk:"local_retention"`
+	Remote          types.Bool   `tfsdk:"remote"`
+	RemoteType      types.String `tfsdk:"remote_type"`
+	RemotePath      types.String `tfsdk:"remote_path"`
+	RemoteRetention types.String `tfsdk:"remote_retention"`
+	RemoteConfig    types.String `tfsdk:"remote_config"`
+	Timeout         types.String `tfsdk:"timeout"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+	Status          types.String `tfsdk:"status"`
+	Exists          types.Bool   `tfsdk:"exists"`
+}
+
+// refreshStatus looks the snapshot up by tag in the backup catalog and by ID in the run status
+func (r *cassandraSnapshotResource) refreshStatus(ctx context.Context, data *cassandraSnapshotResourceData) error {
+	backups, err := r.client.GetCassandraBackups(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		return err
+	}
+
+	exists := false
+	for _, b := range backups {
+		if b.Tag == data.Tag.ValueString() {
+			exists = true
+			break
+		}
+	}
+
+	status, err := r.client.GetCassandraBackupStatus(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.Status = types.StringValue("")
+	if status != nil {
+		data.Status = types.StringValue(status.Status)
+		exists = true
+	}
+	data.Exists = types.BoolValue(exists)
+
+	return nil
+}
+
+func (r *cassandraSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data cassandraSnapshotResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var datacenters, keyspaces, tables, nodes []string
+
+	diags = data.Datacenters.ElementsAs(ctx, &datacenters, false)
+	resp.Diagnostics.Append(diags...)
+	diags = data.Keyspaces.ElementsAs(ctx, &keyspaces, false)
+	resp.Diagnostics.Append(diags...)
+	diags = data.Tables.ElementsAs(ctx, &tables, false)
+	resp.Diagnostics.Append(diags...)
+	diags = data.Nodes.ElementsAs(ctx, &nodes, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if keyspaces == nil {
+		keyspaces = []string{}
+	}
+	if tables == nil {
+		tables = []string{}
+	}
+	if nodes == nil {
+		nodes = []string{}
+	}
+
+	newID := uuid.New().String()
+	data.ID = types.StringValue(newID)
+
+	backup := axonopsClient.CassandraBackup{
+		ID:                     newID,
+		Tag:                    data.Tag.ValueString(),
+		LocalRetentionDuration: data.LocalRetention.ValueString(),
+		Remote:                 data.Remote.ValueBool(),
+		Timeout:                data.Timeout.ValueString(),
+		Datacenters:            datacenters,
+		Nodes:                  nodes,
+		Tables:                 tables,
+		Keyspaces:              keyspaces,
+		AllTables:              len(tables) == 0,
+		AllNodes:               len(nodes) == 0,
+		Schedule:               false,
+	}
+
+	if data.Remote.ValueBool() {
+		backup.RemoteType = data.RemoteType.ValueString()
+		backup.RemotePath = data.RemotePath.ValueString()
+		backup.RemoteRetentionDuration = data.RemoteRetention.ValueString()
+		backup.RemoteConfig = data.RemoteConfig.ValueString()
+	}
+
+	err := r.client.CreateCassandraBackup(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), backup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to take snapshot: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Triggered Cassandra snapshot %s", newID))
+
+	// The snapshot was taken, so a failed lookup only leaves the status unknown
+	err = r.refreshStatus(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to read snapshot status: %s", err))
+		data.Status = types.StringValue("")
+		data.Exists = types.BoolValue(true)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cassandraSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data cassandraSnapshotResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource is kept even when the snapshot is gone, e.g. after local retention
+	// expired, since removing it would take a new snapshot on the next apply
+	err := r.refreshStatus(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read snapshot status: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cassandraSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data cassandraSnapshotResourceData
+
+	// Every configurable attribute requires replacement, so only computed values can change here
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refreshStatus(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read snapshot status: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *cassandraSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A snapshot that has been taken can't be undone; it expires with local_retention
+	tflog.Info(ctx, "Removed Cassandra snapshot resource from state, the snapshot itself is kept")
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*dseWorkloadResource)(nil)
+var _ resource.ResourceWithImportState = (*dseWorkloadResource)(nil)
+
+// dseWorkloadTypes are the workload types DSE can run on a node
+var dseWorkloadTypes = []string{"Analytics", "Search", "Graph", "CassandraDB", "SearchAnalytics"}
+
+// defaultDseWorkload is the plain Cassandra workload, restored when the resource is destroyed
+const defaultDseWorkload = "CassandraDB"
+
+type dseWorkloadResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewDseWorkloadResource() resource.Resource {
+	return &dseWorkloadResource{}
+}
+
+func (r *dseWorkloadResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dseWorkloadResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dse_workload"
+}
+
+func (r *dseWorkloadResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The assignment belongs to the node, so moving it recreates it
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the workload type of a node of a DataStax Enterprise cluster. Destroying the resource sets the node back to CassandraDB.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the DSE cluster.",
+				PlanModifiers: replaceString,
+			},
+			"node_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the node.",
+				PlanModifiers: replaceString,
+			},
+			"workload_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The workload type of the node. Valid values: " + strings.Join(dseWorkloadTypes, ", ") + ".",
+				Validators: []validator.String{
+					stringvalidator.OneOf(dseWorkloadTypes...),
+				},
+			},
+		},
+	}
+}
+
+type dseWorkloadResourceData struct {
+	ClusterName  types.String `tfsdk:"cluster_name"`
+	NodeID       types.String `tfsdk:"node_id"`
+	WorkloadType types.String `tfsdk:"workload_type"`
+}
+
+func (r *dseWorkloadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dseWorkloadResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetNodeWorkload(ctx, data.ClusterName.ValueString(), data.NodeID.ValueString(), data.WorkloadType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set node workload: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Created DSE workload resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dseWorkloadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dseWorkloadResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workloadType, err := r.client.GetNodeWorkload(ctx, data.ClusterName.ValueString(), data.NodeID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node workload: %s", err))
+		return
+	}
+
+	data.WorkloadType = types.StringValue(workloadType)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dseWorkloadResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dseWorkloadResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetNodeWorkload(ctx, data.ClusterName.ValueString(), data.NodeID.ValueString(), data.WorkloadType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set node workload: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Updated DSE workload resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dseWorkloadResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dseWorkloadResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetNodeWorkload(ctx, data.ClusterName.ValueString(), data.NodeID.ValueString(), defaultDseWorkload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset node workload: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted (reset) DSE workload resource")
+}
+
+// ImportState imports the workload type of a node.
+// Import ID format: cluster_name/node_id
+func (r *dseWorkloadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterName, nodeID, _ := strings.Cut(req.ID, "/")
+	if clusterName == "" || nodeID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_name/node_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	workloadType, err := r.client.GetNodeWorkload(ctx, clusterName, nodeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read node workload: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_id"), nodeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workload_type"), workloadType)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported DSE workload for %s", req.ID))
+}
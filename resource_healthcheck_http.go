@@ -13,9 +13,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -57,12 +59,13 @@ func (r *httpHealthcheckResource) Metadata(_ context.Context, req resource.Metad
 
 func (r *httpHealthcheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages an HTTP healthcheck configuration for a Kafka cluster.",
+		Description: "Manages an HTTP healthcheck configuration for a cluster.",
 		Attributes: map[string]schema.Attribute{
 			"cluster_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The name of the Kafka cluster.",
+				Description: "The name of the cluster.",
 			},
+			"cluster_type": clusterTypeAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the healthcheck.",
@@ -100,6 +103,19 @@ func (r *httpHealthcheckResource) Schema(ctx context.Context, req resource.Schem
 				Default:     int64default.StaticInt64(200),
 				Description: "The expected HTTP status code. Default: 200",
 			},
+			"tls_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Skip verification of the TLS certificate of url, for internal services with self-signed certificates. This also accepts certificates of an impersonated host, so prefer adding the CA to the agents where possible. Changing it replaces a read-only healthcheck. Default: false",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfReadonly,
+						"Read-only healthchecks are replaced when tls_skip_verify changes.",
+						"Read-only healthchecks are replaced when `tls_skip_verify` changes.",
+					),
+				},
+			},
 			"interval": schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
@@ -125,12 +141,22 @@ func (r *httpHealthcheckResource) Schema(ctx context.Context, req resource.Schem
 				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("all")})),
 				Description: "List of agent types this healthcheck applies to (e.g., all, broker, kraft-broker, kraft-controller, zookeeper).",
 			},
+			"integrations": healthcheckIntegrationsAttribute(),
 		},
 	}
 }
 
+// requiresReplaceIfReadonly replaces healthchecks that are planned to be read-only, since
+// they can't be edited in place once locked
+func requiresReplaceIfReadonly(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+	var readonly types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("readonly"), &readonly)...)
+	resp.RequiresReplace = readonly.ValueBool()
+}
+
 type httpHealthcheckResourceData struct {
 	ClusterName         types.String `tfsdk:"cluster_name"`
+	ClusterType         types.String `tfsdk:"cluster_type"`
 	Name                types.String `tfsdk:"name"`
 	ID                  types.String `tfsdk:"id"`
 	URL                 types.String `tfsdk:"url"`
@@ -138,9 +164,11 @@ type httpHealthcheckResourceData struct {
 	Headers             types.Map    `tfsdk:"headers"`
 	Body                types.String `tfsdk:"body"`
 	ExpectedStatus      types.Int64  `tfsdk:"expected_status"`
+	TLSSkipVerify       types.Bool   `tfsdk:"tls_skip_verify"`
 	Interval            types.String `tfsdk:"interval"`
 	Timeout             types.String `tfsdk:"timeout"`
 	Readonly            types.Bool   `tfsdk:"readonly"`
+	Integrations        types.Object `tfsdk:"integrations"`
 	SupportedAgentTypes types.List   `tfsdk:"supported_agent_types"`
 }
 
@@ -155,12 +183,22 @@ func (r *httpHealthcheckResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Get existing healthchecks
-	existing, err := r.client.GetHealthchecks(data.ClusterName.ValueString())
+	existing, err := r.client.GetHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing healthchecks, got error: %s", err))
 		return
 	}
 
+	integrations, diags := buildHealthcheckIntegrations(ctx, data.Integrations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// A new check without integrations uses the default routing
+	if data.Integrations.IsUnknown() {
+		data.Integrations = types.ObjectNull(healthcheckIntegrationsAttrTypes)
+	}
+
 	// Generate a new UUID for this healthcheck
 	newID := uuid.New().String()
 
@@ -189,24 +227,19 @@ func (r *httpHealthcheckResource) Create(ctx context.Context, req resource.Creat
 		Headers:            headers,
 		Body:               data.Body.ValueString(),
 		ExpectedStatus:     int(data.ExpectedStatus.ValueInt64()),
+		TLSSkipVerify:      data.TLSSkipVerify.ValueBool(),
 		Interval:           data.Interval.ValueString(),
 		Timeout:            data.Timeout.ValueString(),
 		Readonly:           data.Readonly.ValueBool(),
 		SupportedAgentType: supportedAgentTypes,
-		Integrations: axonopsClient.HealthcheckIntegrations{
-			Type:            "",
-			Routing:         nil,
-			OverrideInfo:    false,
-			OverrideWarning: false,
-			OverrideError:   false,
-		},
+		Integrations:       integrations,
 	}
 
 	// Add to existing healthchecks
 	existing.HTTPChecks = append(existing.HTTPChecks, newCheck)
 
 	// Update all healthchecks
-	err = r.client.UpdateHealthchecks(data.ClusterName.ValueString(), *existing)
+	err = r.client.UpdateHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), *existing)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTP healthcheck, got error: %s", err))
 		return
@@ -231,8 +264,10 @@ func (r *httpHealthcheckResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	data.ClusterType = clusterTypeOrDefault(data.ClusterType)
+
 	// Get all healthchecks
-	healthchecks, err := r.client.GetHealthchecks(data.ClusterName.ValueString())
+	healthchecks, err := r.client.GetHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read healthchecks, got error: %s", err))
 		return
@@ -259,10 +294,14 @@ func (r *httpHealthcheckResource) Read(ctx context.Context, req resource.ReadReq
 	data.Method = types.StringValue(found.Method)
 	data.Body = types.StringValue(found.Body)
 	data.ExpectedStatus = types.Int64Value(int64(found.ExpectedStatus))
+	data.TLSSkipVerify = types.BoolValue(found.TLSSkipVerify)
 	data.Interval = types.StringValue(found.Interval)
 	data.Timeout = types.StringValue(found.Timeout)
 	data.Readonly = types.BoolValue(found.Readonly)
 
+	data.Integrations, diags = flattenHealthcheckIntegrations(ctx, found.Integrations)
+	resp.Diagnostics.Append(diags...)
+
 	// Convert headers to map
 	data.Headers, diags = types.MapValueFrom(ctx, types.StringType, found.Headers)
 	resp.Diagnostics.Append(diags...)
@@ -286,6 +325,8 @@ func (r *httpHealthcheckResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	planData.ClusterType = clusterTypeOrDefault(planData.ClusterType)
+
 	diags = req.State.Get(ctx, &stateData)
 	resp.Diagnostics.Append(diags...)
 
@@ -294,7 +335,7 @@ func (r *httpHealthcheckResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Get existing healthchecks
-	existing, err := r.client.GetHealthchecks(planData.ClusterName.ValueString())
+	existing, err := r.client.GetHealthchecks(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing healthchecks, got error: %s", err))
 		return
@@ -320,6 +361,12 @@ func (r *httpHealthcheckResource) Update(ctx context.Context, req resource.Updat
 	found := false
 	for i, c := range existing.HTTPChecks {
 		if c.Name == stateData.Name.ValueString() {
+			integrations, diags := updateHealthcheckIntegrations(ctx, &planData.Integrations, c.Integrations)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
 			existing.HTTPChecks[i] = axonopsClient.HTTPHealthcheck{
 				ID:                 c.ID,
 				Name:               planData.Name.ValueString(),
@@ -328,11 +375,12 @@ func (r *httpHealthcheckResource) Update(ctx context.Context, req resource.Updat
 				Headers:            headers,
 				Body:               planData.Body.ValueString(),
 				ExpectedStatus:     int(planData.ExpectedStatus.ValueInt64()),
+				TLSSkipVerify:      planData.TLSSkipVerify.ValueBool(),
 				Interval:           planData.Interval.ValueString(),
 				Timeout:            planData.Timeout.ValueString(),
 				Readonly:           planData.Readonly.ValueBool(),
 				SupportedAgentType: supportedAgentTypes,
-				Integrations:       c.Integrations,
+				Integrations:       integrations,
 			}
 			found = true
 			break
@@ -345,7 +393,7 @@ func (r *httpHealthcheckResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Update all healthchecks
-	err = r.client.UpdateHealthchecks(planData.ClusterName.ValueString(), *existing)
+	err = r.client.UpdateHealthchecks(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), *existing)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update HTTP healthcheck, got error: %s", err))
 		return
@@ -370,8 +418,10 @@ func (r *httpHealthcheckResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	data.ClusterType = clusterTypeOrDefault(data.ClusterType)
+
 	// Get existing healthchecks
-	existing, err := r.client.GetHealthchecks(data.ClusterName.ValueString())
+	existing, err := r.client.GetHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing healthchecks, got error: %s", err))
 		return
@@ -387,7 +437,7 @@ func (r *httpHealthcheckResource) Delete(ctx context.Context, req resource.Delet
 	existing.HTTPChecks = updatedChecks
 
 	// Update all healthchecks (without our deleted one)
-	err = r.client.UpdateHealthchecks(data.ClusterName.ValueString(), *existing)
+	err = r.client.UpdateHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), *existing)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete HTTP healthcheck, got error: %s", err))
 		return
@@ -397,23 +447,28 @@ func (r *httpHealthcheckResource) Delete(ctx context.Context, req resource.Delet
 }
 
 // ImportState imports an existing HTTP healthcheck into Terraform state.
-// Import ID format: cluster_name/healthcheck_name
+// Import ID format: cluster_type/cluster_name/healthcheck_name, or cluster_name/healthcheck_name for Kafka clusters
 func (r *httpHealthcheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Parse the import ID
 	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
+	if len(parts) == 2 {
+		// IDs without a cluster type predate Cassandra support
+		parts = append([]string{"kafka"}, parts...)
+	}
+	if len(parts) != 3 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: cluster_name/healthcheck_name, got: %s", req.ID),
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/healthcheck_name, got: %s", req.ID),
 		)
 		return
 	}
 
-	clusterName := parts[0]
-	healthcheckName := parts[1]
+	clusterType := parts[0]
+	clusterName := parts[1]
+	healthcheckName := parts[2]
 
 	// Get all healthchecks
-	healthchecks, err := r.client.GetHealthchecks(clusterName)
+	healthchecks, err := r.client.GetHealthchecks(ctx, clusterType, clusterName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
@@ -434,13 +489,14 @@ func (r *httpHealthcheckResource) ImportState(ctx context.Context, req resource.
 	if found == nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
-			fmt.Sprintf("HTTP healthcheck %s not found in cluster %s", healthcheckName, clusterName),
+			fmt.Sprintf("HTTP healthcheck %s not found in cluster %s/%s", healthcheckName, clusterType, clusterName),
 		)
 		return
 	}
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), found.Name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), found.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), found.URL)...)
@@ -448,10 +504,15 @@ func (r *httpHealthcheckResource) ImportState(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("headers"), found.Headers)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("body"), found.Body)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("expected_status"), int64(found.ExpectedStatus))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tls_skip_verify"), found.TLSSkipVerify)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interval"), found.Interval)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), found.Timeout)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("readonly"), found.Readonly)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("supported_agent_types"), found.SupportedAgentType)...)
 
-	tflog.Info(ctx, fmt.Sprintf("Imported HTTP healthcheck %s from cluster %s", healthcheckName, clusterName))
+	integrations, diags := flattenHealthcheckIntegrations(ctx, found.Integrations)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integrations"), integrations)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported HTTP healthcheck %s from cluster %s/%s", healthcheckName, clusterType, clusterName))
 }
@@ -8,12 +8,23 @@ import (
 	axonopsClient "terraform-provider-axonops/client"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -53,12 +64,13 @@ func (r *shellHealthcheckResource) Metadata(_ context.Context, req resource.Meta
 
 func (r *shellHealthcheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a shell healthcheck configuration for a Kafka cluster.",
+		Description: "Manages a shell healthcheck configuration for a cluster.",
 		Attributes: map[string]schema.Attribute{
 			"cluster_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The name of the Kafka cluster.",
+				Description: "The name of the cluster.",
 			},
+			"cluster_type": clusterTypeAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the healthcheck.",
@@ -95,19 +107,230 @@ func (r *shellHealthcheckResource) Schema(ctx context.Context, req resource.Sche
 				Default:     booldefault.StaticBool(false),
 				Description: "Whether the healthcheck is read-only. Default: false",
 			},
+			"success_exit_codes": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(0)})),
+				Description: "Script exit codes (0-255) that count as healthy. Default: [0]",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueInt64sAre(int64validator.Between(0, 255)),
+				},
+			},
+			"env": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Environment variables set for the script, e.g. credentials it needs. The values are stored in the Terraform state and aren't refreshed from AxonOps, so changes made outside Terraform aren't detected.",
+			},
+			"integrations": healthcheckIntegrationsAttribute(),
 		},
 	}
 }
 
 type shellHealthcheckResourceData struct {
-	ClusterName types.String `tfsdk:"cluster_name"`
-	Name        types.String `tfsdk:"name"`
-	ID          types.String `tfsdk:"id"`
-	Script      types.String `tfsdk:"script"`
-	Shell       types.String `tfsdk:"shell"`
-	Interval    types.String `tfsdk:"interval"`
-	Timeout     types.String `tfsdk:"timeout"`
-	Readonly    types.Bool   `tfsdk:"readonly"`
+	ClusterName  types.String `tfsdk:"cluster_name"`
+	ClusterType  types.String `tfsdk:"cluster_type"`
+	Name         types.String `tfsdk:"name"`
+	ID           types.String `tfsdk:"id"`
+	Script       types.String `tfsdk:"script"`
+	Shell        types.String `tfsdk:"shell"`
+	Interval     types.String `tfsdk:"interval"`
+	Timeout      types.String `tfsdk:"timeout"`
+	Readonly     types.Bool   `tfsdk:"readonly"`
+	Integrations types.Object `tfsdk:"integrations"`
+
+	SuccessExitCodes types.List `tfsdk:"success_exit_codes"`
+	Env              types.Map  `tfsdk:"env"`
+}
+
+// env converts the env attribute to the API representation
+func (d *shellHealthcheckResourceData) env(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	if d.Env.IsNull() {
+		return nil, nil
+	}
+
+	env := map[string]string{}
+	diags := d.Env.ElementsAs(ctx, &env, false)
+	return env, diags
+}
+
+// successExitCodes converts the success_exit_codes attribute to the API representation
+func (d *shellHealthcheckResourceData) successExitCodes(ctx context.Context) ([]int, diag.Diagnostics) {
+	var codes []int64
+	diags := d.SuccessExitCodes.ElementsAs(ctx, &codes, false)
+
+	result := make([]int, 0, len(codes))
+	for _, code := range codes {
+		result = append(result, int(code))
+	}
+	return result, diags
+}
+
+// flattenSuccessExitCodes converts API exit codes to the success_exit_codes attribute.
+// Checks created before exit codes were configurable succeed on 0 only.
+func flattenSuccessExitCodes(ctx context.Context, codes []int) (types.List, diag.Diagnostics) {
+	if len(codes) == 0 {
+		codes = []int{0}
+	}
+
+	values := make([]int64, 0, len(codes))
+	for _, code := range codes {
+		values = append(values, int64(code))
+	}
+	return types.ListValueFrom(ctx, types.Int64Type, values)
+}
+
+// healthcheckIntegrationsAttrTypes describes the integrations attribute shared by the healthcheck resources
+var healthcheckIntegrationsAttrTypes = map[string]attr.Type{
+	"type":             types.StringType,
+	"routing":          types.ListType{ElemType: types.StringType},
+	"override_info":    types.BoolType,
+	"override_warning": types.BoolType,
+	"override_error":   types.BoolType,
+}
+
+type healthcheckIntegrationsData struct {
+	Type            types.String   `tfsdk:"type"`
+	Routing         []types.String `tfsdk:"routing"`
+	OverrideInfo    types.Bool     `tfsdk:"override_info"`
+	OverrideWarning types.Bool     `tfsdk:"override_warning"`
+	OverrideError   types.Bool     `tfsdk:"override_error"`
+}
+
+// clusterTypeAttribute returns the schema of the cluster_type attribute of healthchecks and
+// log collectors. They move to a new cluster type by replacement, so nothing is left behind
+// on the old one.
+func clusterTypeAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString("kafka"),
+		Description: "The cluster type (kafka, cassandra, or dse). Changing it creates a new resource. Default: kafka",
+		Validators: []validator.String{
+			stringvalidator.OneOf("kafka", "cassandra", "dse"),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIf(
+				requiresReplaceIfClusterTypeChanged,
+				"Changing the cluster type creates a new resource.",
+				"Changing the cluster type creates a new resource.",
+			),
+		},
+	}
+}
+
+// requiresReplaceIfClusterTypeChanged replaces the resource when cluster_type changes, but
+// not when it's only being filled in for state written before the attribute existed
+func requiresReplaceIfClusterTypeChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !clusterTypeOrDefault(req.StateValue).Equal(req.PlanValue)
+}
+
+// clusterTypeOrDefault returns the cluster type, or kafka when it's unset. State written
+// before cluster_type was added has no value, and those resources are all on Kafka clusters.
+func clusterTypeOrDefault(clusterType types.String) types.String {
+	if clusterType.IsNull() || clusterType.ValueString() == "" {
+		return types.StringValue("kafka")
+	}
+	return clusterType
+}
+
+// healthcheckIntegrationsAttribute returns the schema of the integrations attribute
+func healthcheckIntegrationsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Routes failures of the healthcheck to integrations. When omitted, the routing already set on the check (e.g. in the UI) is kept, or the cluster's default routing applies to new checks.",
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The integration type (email, smtp, pagerduty, slack, teams, servicenow, webhook, opsgenie).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(integrationTypes...),
+				},
+			},
+			"routing": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The IDs of the integrations to notify.",
+			},
+			"override_info": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Route info level events to these integrations instead of the default routing. Default: false",
+			},
+			"override_warning": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Route warning level events to these integrations instead of the default routing. Default: false",
+			},
+			"override_error": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Route error level events to these integrations instead of the default routing. Default: false",
+			},
+		},
+	}
+}
+
+// buildHealthcheckIntegrations converts the integrations attribute to the API representation
+func buildHealthcheckIntegrations(ctx context.Context, obj types.Object) (axonopsClient.HealthcheckIntegrations, diag.Diagnostics) {
+	var result axonopsClient.HealthcheckIntegrations
+	if obj.IsNull() || obj.IsUnknown() {
+		return result, nil
+	}
+
+	var data healthcheckIntegrationsData
+	diags := obj.As(ctx, &data, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return result, diags
+	}
+
+	result.Type = data.Type.ValueString()
+	for _, id := range data.Routing {
+		result.Routing = append(result.Routing, id.ValueString())
+	}
+	result.OverrideInfo = data.OverrideInfo.ValueBool()
+	result.OverrideWarning = data.OverrideWarning.ValueBool()
+	result.OverrideError = data.OverrideError.ValueBool()
+
+	return result, diags
+}
+
+// flattenHealthcheckIntegrations converts API integrations to the integrations attribute.
+// Checks without integrations give a null object so an unset attribute doesn't show a diff.
+func flattenHealthcheckIntegrations(ctx context.Context, integrations axonopsClient.HealthcheckIntegrations) (types.Object, diag.Diagnostics) {
+	if integrations.Type == "" && len(integrations.Routing) == 0 {
+		return types.ObjectNull(healthcheckIntegrationsAttrTypes), nil
+	}
+
+	return types.ObjectValueFrom(ctx, healthcheckIntegrationsAttrTypes, healthcheckIntegrationsData{
+		Type:            types.StringValue(integrations.Type),
+		Routing:         stringValues(integrations.Routing),
+		OverrideInfo:    types.BoolValue(integrations.OverrideInfo),
+		OverrideWarning: types.BoolValue(integrations.OverrideWarning),
+		OverrideError:   types.BoolValue(integrations.OverrideError),
+	})
+}
+
+// updateHealthcheckIntegrations returns the integrations to send when updating a check.
+// When the attribute isn't configured the existing integrations of the check are kept and
+// written back to planned, since the healthchecks payload replaces all of them.
+func updateHealthcheckIntegrations(ctx context.Context, planned *types.Object, existing axonopsClient.HealthcheckIntegrations) (axonopsClient.HealthcheckIntegrations, diag.Diagnostics) {
+	if !planned.IsUnknown() {
+		return buildHealthcheckIntegrations(ctx, *planned)
+	}
+
+	var diags diag.Diagnostics
+	*planned, diags = flattenHealthcheckIntegrations(ctx, existing)
+	return existing, diags
 }
 
 func (r *shellHealthcheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -121,38 +344,56 @@ func (r *shellHealthcheckResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Get existing healthchecks
-	existing, err := r.client.GetHealthchecks(data.ClusterName.ValueString())
+	existing, err := r.client.GetHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing healthchecks, got error: %s", err))
 		return
 	}
 
+	successExitCodes, diags := data.successExitCodes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	env, diags := data.env(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrations, diags := buildHealthcheckIntegrations(ctx, data.Integrations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// A new check without integrations uses the default routing
+	if data.Integrations.IsUnknown() {
+		data.Integrations = types.ObjectNull(healthcheckIntegrationsAttrTypes)
+	}
+
 	// Generate a new UUID for this healthcheck
 	newID := uuid.New().String()
 
 	// Create the new healthcheck
 	newCheck := axonopsClient.ShellHealthcheck{
-		ID:       newID,
-		Name:     data.Name.ValueString(),
-		Script:   data.Script.ValueString(),
-		Shell:    data.Shell.ValueString(),
-		Interval: data.Interval.ValueString(),
-		Timeout:  data.Timeout.ValueString(),
-		Readonly: data.Readonly.ValueBool(),
-		Integrations: axonopsClient.HealthcheckIntegrations{
-			Type:            "",
-			Routing:         nil,
-			OverrideInfo:    false,
-			OverrideWarning: false,
-			OverrideError:   false,
-		},
+		ID:               newID,
+		Name:             data.Name.ValueString(),
+		Script:           data.Script.ValueString(),
+		Shell:            data.Shell.ValueString(),
+		Interval:         data.Interval.ValueString(),
+		Timeout:          data.Timeout.ValueString(),
+		Readonly:         data.Readonly.ValueBool(),
+		Integrations:     integrations,
+		SuccessExitCodes: successExitCodes,
+		Env:              env,
 	}
 
 	// Add to existing healthchecks
 	existing.ShellChecks = append(existing.ShellChecks, newCheck)
 
 	// Update all healthchecks
-	err = r.client.UpdateHealthchecks(data.ClusterName.ValueString(), *existing)
+	err = r.client.UpdateHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), *existing)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create shell healthcheck, got error: %s", err))
 		return
@@ -177,8 +418,10 @@ func (r *shellHealthcheckResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	data.ClusterType = clusterTypeOrDefault(data.ClusterType)
+
 	// Get all healthchecks
-	healthchecks, err := r.client.GetHealthchecks(data.ClusterName.ValueString())
+	healthchecks, err := r.client.GetHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read healthchecks, got error: %s", err))
 		return
@@ -207,6 +450,14 @@ func (r *shellHealthcheckResource) Read(ctx context.Context, req resource.ReadRe
 	data.Timeout = types.StringValue(found.Timeout)
 	data.Readonly = types.BoolValue(found.Readonly)
 
+	data.Integrations, diags = flattenHealthcheckIntegrations(ctx, found.Integrations)
+	resp.Diagnostics.Append(diags...)
+
+	data.SuccessExitCodes, diags = flattenSuccessExitCodes(ctx, found.SuccessExitCodes)
+	resp.Diagnostics.Append(diags...)
+
+	// env is kept from state since the API may redact the values
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -222,6 +473,8 @@ func (r *shellHealthcheckResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	planData.ClusterType = clusterTypeOrDefault(planData.ClusterType)
+
 	diags = req.State.Get(ctx, &stateData)
 	resp.Diagnostics.Append(diags...)
 
@@ -229,8 +482,20 @@ func (r *shellHealthcheckResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	successExitCodes, diags := planData.successExitCodes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	env, diags := planData.env(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get existing healthchecks
-	existing, err := r.client.GetHealthchecks(planData.ClusterName.ValueString())
+	existing, err := r.client.GetHealthchecks(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing healthchecks, got error: %s", err))
 		return
@@ -240,6 +505,12 @@ func (r *shellHealthcheckResource) Update(ctx context.Context, req resource.Upda
 	found := false
 	for i, c := range existing.ShellChecks {
 		if c.Name == stateData.Name.ValueString() {
+			integrations, diags := updateHealthcheckIntegrations(ctx, &planData.Integrations, c.Integrations)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
 			existing.ShellChecks[i] = axonopsClient.ShellHealthcheck{
 				ID:           c.ID,
 				Name:         planData.Name.ValueString(),
@@ -248,7 +519,10 @@ func (r *shellHealthcheckResource) Update(ctx context.Context, req resource.Upda
 				Interval:     planData.Interval.ValueString(),
 				Timeout:      planData.Timeout.ValueString(),
 				Readonly:     planData.Readonly.ValueBool(),
-				Integrations: c.Integrations,
+				Integrations: integrations,
+
+				SuccessExitCodes: successExitCodes,
+				Env:              env,
 			}
 			found = true
 			break
@@ -261,7 +535,7 @@ func (r *shellHealthcheckResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Update all healthchecks
-	err = r.client.UpdateHealthchecks(planData.ClusterName.ValueString(), *existing)
+	err = r.client.UpdateHealthchecks(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), *existing)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update shell healthcheck, got error: %s", err))
 		return
@@ -286,8 +560,10 @@ func (r *shellHealthcheckResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	data.ClusterType = clusterTypeOrDefault(data.ClusterType)
+
 	// Get existing healthchecks
-	existing, err := r.client.GetHealthchecks(data.ClusterName.ValueString())
+	existing, err := r.client.GetHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing healthchecks, got error: %s", err))
 		return
@@ -303,7 +579,7 @@ func (r *shellHealthcheckResource) Delete(ctx context.Context, req resource.Dele
 	existing.ShellChecks = updatedChecks
 
 	// Update all healthchecks (without our deleted one)
-	err = r.client.UpdateHealthchecks(data.ClusterName.ValueString(), *existing)
+	err = r.client.UpdateHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), *existing)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete shell healthcheck, got error: %s", err))
 		return
@@ -313,23 +589,28 @@ func (r *shellHealthcheckResource) Delete(ctx context.Context, req resource.Dele
 }
 
 // ImportState imports an existing shell healthcheck into Terraform state.
-// Import ID format: cluster_name/healthcheck_name
+// Import ID format: cluster_type/cluster_name/healthcheck_name, or cluster_name/healthcheck_name for Kafka clusters
 func (r *shellHealthcheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Parse the import ID
 	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
+	if len(parts) == 2 {
+		// IDs without a cluster type predate Cassandra support
+		parts = append([]string{"kafka"}, parts...)
+	}
+	if len(parts) != 3 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: cluster_name/healthcheck_name, got: %s", req.ID),
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/healthcheck_name, got: %s", req.ID),
 		)
 		return
 	}
 
-	clusterName := parts[0]
-	healthcheckName := parts[1]
+	clusterType := parts[0]
+	clusterName := parts[1]
+	healthcheckName := parts[2]
 
 	// Get all healthchecks
-	healthchecks, err := r.client.GetHealthchecks(clusterName)
+	healthchecks, err := r.client.GetHealthchecks(ctx, clusterType, clusterName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
@@ -350,13 +631,14 @@ func (r *shellHealthcheckResource) ImportState(ctx context.Context, req resource
 	if found == nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
-			fmt.Sprintf("Shell healthcheck %s not found in cluster %s", healthcheckName, clusterName),
+			fmt.Sprintf("Shell healthcheck %s not found in cluster %s/%s", healthcheckName, clusterType, clusterName),
 		)
 		return
 	}
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), found.Name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), found.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("script"), found.Script)...)
@@ -365,5 +647,18 @@ func (r *shellHealthcheckResource) ImportState(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), found.Timeout)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("readonly"), found.Readonly)...)
 
-	tflog.Info(ctx, fmt.Sprintf("Imported shell healthcheck %s from cluster %s", healthcheckName, clusterName))
+	integrations, diags := flattenHealthcheckIntegrations(ctx, found.Integrations)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integrations"), integrations)...)
+
+	successExitCodes, diags := flattenSuccessExitCodes(ctx, found.SuccessExitCodes)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("success_exit_codes"), successExitCodes)...)
+
+	// env is only read on import, since the API may redact the values
+	if len(found.Env) > 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("env"), found.Env)...)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Imported shell healthcheck %s from cluster %s/%s", healthcheckName, clusterType, clusterName))
 }
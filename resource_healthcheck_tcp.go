@@ -55,12 +55,13 @@ func (r *tcpHealthcheckResource) Metadata(_ context.Context, req resource.Metada
 
 func (r *tcpHealthcheckResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a TCP healthcheck configuration for a Kafka cluster.",
+		Description: "Manages a TCP healthcheck configuration for a cluster.",
 		Attributes: map[string]schema.Attribute{
 			"cluster_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The name of the Kafka cluster.",
+				Description: "The name of the cluster.",
 			},
+			"cluster_type": clusterTypeAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the healthcheck.",
@@ -98,18 +99,21 @@ func (r *tcpHealthcheckResource) Schema(ctx context.Context, req resource.Schema
 				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("all")})),
 				Description: "List of agent types this healthcheck applies to (e.g., all, broker, kraft-broker, kraft-controller, zookeeper).",
 			},
+			"integrations": healthcheckIntegrationsAttribute(),
 		},
 	}
 }
 
 type tcpHealthcheckResourceData struct {
 	ClusterName         types.String `tfsdk:"cluster_name"`
+	ClusterType         types.String `tfsdk:"cluster_type"`
 	Name                types.String `tfsdk:"name"`
 	ID                  types.String `tfsdk:"id"`
 	TCP                 types.String `tfsdk:"tcp"`
 	Interval            types.String `tfsdk:"interval"`
 	Timeout             types.String `tfsdk:"timeout"`
 	Readonly            types.Bool   `tfsdk:"readonly"`
+	Integrations        types.Object `tfsdk:"integrations"`
 	SupportedAgentTypes types.List   `tfsdk:"supported_agent_types"`
 }
 
@@ -124,12 +128,22 @@ func (r *tcpHealthcheckResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Get existing healthchecks
-	existing, err := r.client.GetHealthchecks(data.ClusterName.ValueString())
+	existing, err := r.client.GetHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing healthchecks, got error: %s", err))
 		return
 	}
 
+	integrations, diags := buildHealthcheckIntegrations(ctx, data.Integrations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// A new check without integrations uses the default routing
+	if data.Integrations.IsUnknown() {
+		data.Integrations = types.ObjectNull(healthcheckIntegrationsAttrTypes)
+	}
+
 	// Generate a new UUID for this healthcheck
 	newID := uuid.New().String()
 
@@ -150,20 +164,14 @@ func (r *tcpHealthcheckResource) Create(ctx context.Context, req resource.Create
 		Timeout:            data.Timeout.ValueString(),
 		Readonly:           data.Readonly.ValueBool(),
 		SupportedAgentType: supportedAgentTypes,
-		Integrations: axonopsClient.HealthcheckIntegrations{
-			Type:            "",
-			Routing:         nil,
-			OverrideInfo:    false,
-			OverrideWarning: false,
-			OverrideError:   false,
-		},
+		Integrations:       integrations,
 	}
 
 	// Add to existing healthchecks
 	existing.TCPChecks = append(existing.TCPChecks, newCheck)
 
 	// Update all healthchecks
-	err = r.client.UpdateHealthchecks(data.ClusterName.ValueString(), *existing)
+	err = r.client.UpdateHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), *existing)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create TCP healthcheck, got error: %s", err))
 		return
@@ -188,8 +196,10 @@ func (r *tcpHealthcheckResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	data.ClusterType = clusterTypeOrDefault(data.ClusterType)
+
 	// Get all healthchecks
-	healthchecks, err := r.client.GetHealthchecks(data.ClusterName.ValueString())
+	healthchecks, err := r.client.GetHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read healthchecks, got error: %s", err))
 		return
@@ -217,6 +227,9 @@ func (r *tcpHealthcheckResource) Read(ctx context.Context, req resource.ReadRequ
 	data.Timeout = types.StringValue(found.Timeout)
 	data.Readonly = types.BoolValue(found.Readonly)
 
+	data.Integrations, diags = flattenHealthcheckIntegrations(ctx, found.Integrations)
+	resp.Diagnostics.Append(diags...)
+
 	// Convert supported agent types to list
 	data.SupportedAgentTypes, diags = types.ListValueFrom(ctx, types.StringType, found.SupportedAgentType)
 	resp.Diagnostics.Append(diags...)
@@ -236,6 +249,8 @@ func (r *tcpHealthcheckResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	planData.ClusterType = clusterTypeOrDefault(planData.ClusterType)
+
 	diags = req.State.Get(ctx, &stateData)
 	resp.Diagnostics.Append(diags...)
 
@@ -244,7 +259,7 @@ func (r *tcpHealthcheckResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Get existing healthchecks
-	existing, err := r.client.GetHealthchecks(planData.ClusterName.ValueString())
+	existing, err := r.client.GetHealthchecks(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing healthchecks, got error: %s", err))
 		return
@@ -262,6 +277,12 @@ func (r *tcpHealthcheckResource) Update(ctx context.Context, req resource.Update
 	found := false
 	for i, c := range existing.TCPChecks {
 		if c.Name == stateData.Name.ValueString() {
+			integrations, diags := updateHealthcheckIntegrations(ctx, &planData.Integrations, c.Integrations)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
 			existing.TCPChecks[i] = axonopsClient.TCPHealthcheck{
 				ID:                 c.ID,
 				Name:               planData.Name.ValueString(),
@@ -270,7 +291,7 @@ func (r *tcpHealthcheckResource) Update(ctx context.Context, req resource.Update
 				Timeout:            planData.Timeout.ValueString(),
 				Readonly:           planData.Readonly.ValueBool(),
 				SupportedAgentType: supportedAgentTypes,
-				Integrations:       c.Integrations,
+				Integrations:       integrations,
 			}
 			found = true
 			break
@@ -283,7 +304,7 @@ func (r *tcpHealthcheckResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update all healthchecks
-	err = r.client.UpdateHealthchecks(planData.ClusterName.ValueString(), *existing)
+	err = r.client.UpdateHealthchecks(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), *existing)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update TCP healthcheck, got error: %s", err))
 		return
@@ -308,8 +329,10 @@ func (r *tcpHealthcheckResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	data.ClusterType = clusterTypeOrDefault(data.ClusterType)
+
 	// Get existing healthchecks
-	existing, err := r.client.GetHealthchecks(data.ClusterName.ValueString())
+	existing, err := r.client.GetHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing healthchecks, got error: %s", err))
 		return
@@ -325,7 +348,7 @@ func (r *tcpHealthcheckResource) Delete(ctx context.Context, req resource.Delete
 	existing.TCPChecks = updatedChecks
 
 	// Update all healthchecks (without our deleted one)
-	err = r.client.UpdateHealthchecks(data.ClusterName.ValueString(), *existing)
+	err = r.client.UpdateHealthchecks(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), *existing)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete TCP healthcheck, got error: %s", err))
 		return
@@ -335,23 +358,28 @@ func (r *tcpHealthcheckResource) Delete(ctx context.Context, req resource.Delete
 }
 
 // ImportState imports an existing TCP healthcheck into Terraform state.
-// Import ID format: cluster_name/healthcheck_name
+// Import ID format: cluster_type/cluster_name/healthcheck_name, or cluster_name/healthcheck_name for Kafka clusters
 func (r *tcpHealthcheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Parse the import ID
 	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
+	if len(parts) == 2 {
+		// IDs without a cluster type predate Cassandra support
+		parts = append([]string{"kafka"}, parts...)
+	}
+	if len(parts) != 3 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: cluster_name/healthcheck_name, got: %s", req.ID),
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/healthcheck_name, got: %s", req.ID),
 		)
 		return
 	}
 
-	clusterName := parts[0]
-	healthcheckName := parts[1]
+	clusterType := parts[0]
+	clusterName := parts[1]
+	healthcheckName := parts[2]
 
 	// Get all healthchecks
-	healthchecks, err := r.client.GetHealthchecks(clusterName)
+	healthchecks, err := r.client.GetHealthchecks(ctx, clusterType, clusterName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
@@ -372,13 +400,14 @@ func (r *tcpHealthcheckResource) ImportState(ctx context.Context, req resource.I
 	if found == nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
-			fmt.Sprintf("TCP healthcheck %s not found in cluster %s", healthcheckName, clusterName),
+			fmt.Sprintf("TCP healthcheck %s not found in cluster %s/%s", healthcheckName, clusterType, clusterName),
 		)
 		return
 	}
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), found.Name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), found.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tcp"), found.TCP)...)
@@ -387,5 +416,9 @@ func (r *tcpHealthcheckResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("readonly"), found.Readonly)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("supported_agent_types"), found.SupportedAgentType)...)
 
-	tflog.Info(ctx, fmt.Sprintf("Imported TCP healthcheck %s from cluster %s", healthcheckName, clusterName))
+	integrations, diags := flattenHealthcheckIntegrations(ctx, found.Integrations)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("integrations"), integrations)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported TCP healthcheck %s from cluster %s/%s", healthcheckName, clusterType, clusterName))
 }
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*integrationDefinitionResource)(nil)
+var _ resource.ResourceWithImportState = (*integrationDefinitionResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*integrationDefinitionResource)(nil)
+
+type integrationDefinitionResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewIntegrationDefinitionResource() resource.Resource {
+	return &integrationDefinitionResource{}
+}
+
+func (r *integrationDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *integrationDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_definition"
+}
+
+func (r *integrationDefinitionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an integration (e.g., Slack, PagerDuty, email) that alerts can be routed to with axonops_alert_route. The AxonOps integrations API only creates, lists and deletes integrations and has no update call, so any change replaces the integration and gives it a new ID.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of integration: email, smtp, pagerduty, slack, teams, servicenow, webhook, opsgenie.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(integrationTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"params": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Type-specific settings (e.g., channel). Must include name, which axonops_alert_route uses as integration_name.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"sensitive_params": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Type-specific secrets (e.g., url, api_key, routing_key). Merged into params when sent to AxonOps and hidden from plan output.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the integration.",
+			},
+		},
+	}
+}
+
+type integrationDefinitionResourceData struct {
+	ClusterName     types.String `tfsdk:"cluster_name"`
+	ClusterType     types.String `tfsdk:"cluster_type"`
+	Type            types.String `tfsdk:"type"`
+	Params          types.Map    `tfsdk:"params"`
+	SensitiveParams types.Map    `tfsdk:"sensitive_params"`
+	ID              types.String `tfsdk:"id"`
+}
+
+func (r *integrationDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var params, sensitiveParams types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("params"), &params)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sensitive_params"), &sensitiveParams)...)
+	if resp.Diagnostics.HasError() || params.IsUnknown() || params.IsNull() {
+		return
+	}
+
+	name, ok := params.Elements()["name"]
+	if !ok || name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("params"),
+			"Missing Integration Name",
+			"params must include a name entry, which axonops_alert_route uses to find the integration.",
+		)
+	}
+
+	if sensitiveParams.IsUnknown() || sensitiveParams.IsNull() {
+		return
+	}
+	for key := range sensitiveParams.Elements() {
+		if _, ok := params.Elements()[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sensitive_params"),
+				"Duplicate Integration Param",
+				fmt.Sprintf("%q is set in both params and sensitive_params.", key),
+			)
+		}
+	}
+}
+
+// buildIntegrationParams merges params and sensitive_params into the map sent to the API
+func buildIntegrationParams(ctx context.Context, data integrationDefinitionResourceData) (map[string]string, error) {
+	params := map[string]string{}
+	if diags := data.Params.ElementsAs(ctx, &params, false); diags.HasError() {
+		return nil, fmt.Errorf("unable to read params")
+	}
+
+	if !data.SensitiveParams.IsNull() {
+		sensitiveParams := map[string]string{}
+		if diags := data.SensitiveParams.ElementsAs(ctx, &sensitiveParams, false); diags.HasError() {
+			return nil, fmt.Errorf("unable to read sensitive_params")
+		}
+		for k, v := range sensitiveParams {
+			params[k] = v
+		}
+	}
+
+	return params, nil
+}
+
+// createIntegration adds an integration and returns the ID it was given. When the create
+// call doesn't return the ID, it is the one integration of the type and name that wasn't
+// there before, so an existing integration with the same name is never adopted.
+func createIntegration(ctx context.Context, client *axonopsClient.AxonopsHttpClient, clusterType, clusterName, integrationType string, params map[string]string) (string, error) {
+	before, err := client.GetIntegrations(ctx, clusterType, clusterName)
+	if err != nil {
+		return "", fmt.Errorf("unable to get integrations: %w", err)
+	}
+
+	definition := axonopsClient.IntegrationDefinition{
+		Type:   integrationType,
+		Params: params,
+	}
+
+	id, err := client.CreateIntegrationDefinition(ctx, clusterType, clusterName, definition)
+	if err != nil {
+		return "", err
+	}
+	if id != "" {
+		return id, nil
+	}
+
+	after, err := client.GetIntegrations(ctx, clusterType, clusterName)
+	if err != nil {
+		return "", fmt.Errorf("unable to get integrations: %w", err)
+	}
+
+	return findNewIntegrationID(before, after, params["name"], integrationType)
+}
+
+// findNewIntegrationID returns the ID of the integration with the given name and type that
+// is in after but not in before
+func findNewIntegrationID(before, after *axonopsClient.IntegrationsResponse, name, integrationType string) (string, error) {
+	existing := map[string]bool{}
+	for _, definition := range before.Definitions {
+		existing[definition.ID] = true
+	}
+
+	var ids []string
+	for _, definition := range after.Definitions {
+		if !existing[definition.ID] && strings.EqualFold(definition.Type, integrationType) && strings.EqualFold(definition.Params["name"], name) {
+			ids = append(ids, definition.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("integration %s of type %s was created but not found", name, integrationType)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("integration %s of type %s was created more than once at the same time (IDs %s), remove the duplicates and import the one to keep", name, integrationType, strings.Join(ids, ", "))
+	}
+}
+
+// findIntegrationByID returns the integration definition with the given ID, or nil
+func findIntegrationByID(integrations *axonopsClient.IntegrationsResponse, id string) *axonopsClient.IntegrationDefinition {
+	for i := range integrations.Definitions {
+		if integrations.Definitions[i].ID == id {
+			return &integrations.Definitions[i]
+		}
+	}
+	return nil
+}
+
+func (r *integrationDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data integrationDefinitionResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := buildIntegrationParams(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration: %s", err))
+		return
+	}
+
+	id, err := createIntegration(ctx, r.client, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.Type.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration: %s", err))
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	tflog.Info(ctx, "Created integration definition resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *integrationDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data integrationDefinitionResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := r.client.GetIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integration: %s", err))
+		return
+	}
+
+	if definition == nil {
+		// Integration was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Secrets stay in sensitive_params, the rest is refreshed from the API
+	sensitiveKeys := map[string]bool{}
+	if !data.SensitiveParams.IsNull() {
+		for key := range data.SensitiveParams.Elements() {
+			sensitiveKeys[key] = true
+		}
+	}
+
+	params := map[string]string{}
+	for k, v := range definition.Params {
+		if !sensitiveKeys[k] {
+			params[k] = v
+		}
+	}
+
+	data.Type = types.StringValue(strings.ToLower(definition.Type))
+	data.Params, diags = types.MapValueFrom(ctx, types.StringType, params)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called: the API has no call to update integrations, so every attribute requires
+// replacement
+func (r *integrationDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Update Not Supported", "Integrations can't be updated in place, any change replaces the integration.")
+}
+
+func (r *integrationDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data integrationDefinitionResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete integration: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted integration definition resource")
+}
+
+// ImportState imports an existing integration.
+// Import ID format: cluster_type/cluster_name/type/name
+// Secrets are imported into params; move them to sensitive_params in the configuration.
+func (r *integrationDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 4 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/type/name, got: %s", req.ID),
+		)
+		return
+	}
+
+	clusterType := parts[0]
+	clusterName := parts[1]
+	integrationType := parts[2]
+	integrationName := parts[3]
+
+	integrations, err := r.client.GetIntegrations(ctx, clusterType, clusterName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to get integrations: %s", err))
+		return
+	}
+
+	id, err := findIntegrationID(integrations, integrationName, integrationType)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", err.Error())
+		return
+	}
+	definition := findIntegrationByID(integrations, id)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), strings.ToLower(definition.Type))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("params"), definition.Params)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported %s integration %s for %s/%s", integrationType, integrationName, clusterType, clusterName))
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	axonopsClient "terraform-provider-axonops/client"
+)
+
+// fakeIntegrationsAPI serves the integration definitions of a cluster. Created
+// integrations get the next ID, which is only returned from the create call when
+// returnID is set.
+type fakeIntegrationsAPI struct {
+	t *testing.T
+
+	returnID    bool
+	definitions []axonopsClient.IntegrationDefinition
+	nextID      int
+}
+
+func (f *fakeIntegrationsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/v1/integrations/test-org/cassandra/prod" {
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode(axonopsClient.IntegrationsResponse{Definitions: f.definitions})
+
+	case http.MethodPost:
+		var definition axonopsClient.IntegrationDefinition
+		if err := json.NewDecoder(r.Body).Decode(&definition); err != nil {
+			f.t.Errorf("invalid integration: %s", err)
+		}
+		f.nextID++
+		definition.ID = fmt.Sprintf("new-%d", f.nextID)
+		f.definitions = append(f.definitions, definition)
+
+		if f.returnID {
+			json.NewEncoder(w).Encode(definition)
+		} else {
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestCreateIntegrationIgnoresExistingName(t *testing.T) {
+	for _, returnID := range []bool{true, false} {
+		api := &fakeIntegrationsAPI{
+			t:        t,
+			returnID: returnID,
+			// Made in the UI with the same name and type
+			definitions: []axonopsClient.IntegrationDefinition{
+				{ID: "ui-1", Type: "slack", Params: map[string]string{"name": "ops"}},
+			},
+		}
+
+		id, err := createIntegration(context.Background(), newTestClient(t, api), "cassandra", "prod", "slack", map[string]string{"name": "ops"})
+		if err != nil {
+			t.Fatalf("returnID %v: unexpected error: %s", returnID, err)
+		}
+		if id != "new-1" {
+			t.Errorf("returnID %v: id = %s, want new-1", returnID, id)
+		}
+	}
+}
+
+func TestFindNewIntegrationID(t *testing.T) {
+	existing := axonopsClient.IntegrationDefinition{ID: "ui-1", Type: "slack", Params: map[string]string{"name": "ops"}}
+	created := axonopsClient.IntegrationDefinition{ID: "new-1", Type: "slack", Params: map[string]string{"name": "ops"}}
+	duplicate := axonopsClient.IntegrationDefinition{ID: "new-2", Type: "slack", Params: map[string]string{"name": "ops"}}
+	other := axonopsClient.IntegrationDefinition{ID: "new-3", Type: "email", Params: map[string]string{"name": "ops"}}
+
+	before := &axonopsClient.IntegrationsResponse{Definitions: []axonopsClient.IntegrationDefinition{existing}}
+
+	tests := []struct {
+		name      string
+		after     []axonopsClient.IntegrationDefinition
+		wantID    string
+		wantError bool
+	}{
+		{"new integration", []axonopsClient.IntegrationDefinition{existing, other, created}, "new-1", false},
+		{"not created", []axonopsClient.IntegrationDefinition{existing, other}, "", true},
+		{"created twice", []axonopsClient.IntegrationDefinition{existing, created, duplicate}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := findNewIntegrationID(before, &axonopsClient.IntegrationsResponse{Definitions: tt.after}, "ops", "slack")
+			if (err != nil) != tt.wantError {
+				t.Fatalf("error = %v, want error: %v", err, tt.wantError)
+			}
+			if id != tt.wantID {
+				t.Errorf("id = %s, want %s", id, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*emailIntegrationResource)(nil)
+var _ resource.ResourceWithImportState = (*emailIntegrationResource)(nil)
+
+// emailIntegrationType is the integration type of email in the API
+const emailIntegrationType = "email"
+
+// emailAddressRegexp is a loose check that catches values that are clearly not an address
+var emailAddressRegexp = regexp.MustCompile(`^[^@\s,]+@[^@\s,]+$`)
+
+type emailIntegrationResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewIntegrationEmailResource() resource.Resource {
+	return &emailIntegrationResource{}
+}
+
+func (r *emailIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *emailIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_email"
+}
+
+func (r *emailIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an email integration that alerts can be routed to with axonops_alert_route. The AxonOps integrations API only creates, lists and deletes integrations and has no update call, so any change replaces the integration and gives it a new ID.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the integration, which axonops_alert_route uses as integration_name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"smtp_host": schema.StringAttribute{
+				Required:    true,
+				Description: "The hostname of the SMTP server.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"smtp_port": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(587),
+				Description: "The port of the SMTP server. Default: 587",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"smtp_username": schema.StringAttribute{
+				Optional:    true,
+				Description: "The username to authenticate to the SMTP server with.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"smtp_password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password to authenticate to the SMTP server with. It isn't read back from AxonOps, so changes made outside Terraform aren't detected, and it is left unset on import. Setting it after an import only records it in state, changing it later replaces the integration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceIfPasswordChanged,
+						"Changing the SMTP password replaces the integration.",
+						"Changing the SMTP password replaces the integration.",
+					),
+				},
+			},
+			"from_address": schema.StringAttribute{
+				Required:    true,
+				Description: "The address alerts are sent from.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emailAddressRegexp, "must be an email address"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to_addresses": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The addresses alerts are sent to.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(emailAddressRegexp, "must be an email address")),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"use_tls": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to connect to the SMTP server with TLS. Default: true",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"subject_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "Template of the email subject. When omitted, AxonOps uses its default subject.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the integration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type emailIntegrationResourceData struct {
+	ClusterName     types.String `tfsdk:"cluster_name"`
+	ClusterType     types.String `tfsdk:"cluster_type"`
+	Name            types.String `tfsdk:"name"`
+	SmtpHost        types.String `tfsdk:"smtp_host"`
+	SmtpPort        types.Int64  `tfsdk:"smtp_port"`
+	SmtpUsername    types.String `tfsdk:"smtp_username"`
+	SmtpPassword    types.String `tfsdk:"smtp_password"`
+	FromAddress     types.String `tfsdk:"from_address"`
+	ToAddresses     types.List   `tfsdk:"to_addresses"`
+	UseTLS          types.Bool   `tfsdk:"use_tls"`
+	SubjectTemplate types.String `tfsdk:"subject_template"`
+	ID              types.String `tfsdk:"id"`
+}
+
+// requiresReplaceIfPasswordChanged replaces the integration when smtp_password changes, but
+// not when it's only being filled in after an import, which can't read the password back
+func requiresReplaceIfPasswordChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// emailParams converts the resource data to the params of the integration definition.
+// Params only hold strings, so the recipients are sent comma separated.
+func emailParams(ctx context.Context, data emailIntegrationResourceData) (map[string]string, error) {
+	var to []string
+	if diags := data.ToAddresses.ElementsAs(ctx, &to, false); diags.HasError() {
+		return nil, fmt.Errorf("unable to read to_addresses")
+	}
+
+	params := map[string]string{
+		"name":      data.Name.ValueString(),
+		"smtp_host": data.SmtpHost.ValueString(),
+		"smtp_port": strconv.FormatInt(data.SmtpPort.ValueInt64(), 10),
+		"from":      data.FromAddress.ValueString(),
+		"to":        strings.Join(to, ","),
+		"use_tls":   strconv.FormatBool(data.UseTLS.ValueBool()),
+	}
+
+	optional := map[string]types.String{
+		"smtp_username": data.SmtpUsername,
+		"smtp_password": data.SmtpPassword,
+		"subject":       data.SubjectTemplate,
+	}
+	for key, value := range optional {
+		if !value.IsNull() {
+			params[key] = value.ValueString()
+		}
+	}
+
+	return params, nil
+}
+
+// refreshEmailParams sets the attributes that are refreshed from the API, which is everything but the password
+func refreshEmailParams(ctx context.Context, data *emailIntegrationResourceData, params map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Name = types.StringValue(params["name"])
+	data.SmtpHost = types.StringValue(params["smtp_host"])
+	data.FromAddress = types.StringValue(params["from"])
+	data.SmtpUsername = integrationParam(params, "smtp_username")
+	data.SubjectTemplate = integrationParam(params, "subject")
+
+	if port, err := strconv.ParseInt(params["smtp_port"], 10, 64); err == nil {
+		data.SmtpPort = types.Int64Value(port)
+	}
+	if useTLS, err := strconv.ParseBool(params["use_tls"]); err == nil {
+		data.UseTLS = types.BoolValue(useTLS)
+	}
+
+	to := []string{}
+	if params["to"] != "" {
+		to = strings.Split(params["to"], ",")
+	}
+	data.ToAddresses, diags = types.ListValueFrom(ctx, types.StringType, to)
+
+	return diags
+}
+
+func (r *emailIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data emailIntegrationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := emailParams(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create email integration: %s", err))
+		return
+	}
+
+	id, err := createIntegration(ctx, r.client, data.ClusterType.ValueString(), data.ClusterName.ValueString(), emailIntegrationType, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create email integration: %s", err))
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	tflog.Info(ctx, "Created email integration resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *emailIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data emailIntegrationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := r.client.GetIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integration: %s", err))
+		return
+	}
+
+	if definition == nil {
+		// Integration was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The password stays as it is in state, the rest is refreshed from the API
+	resp.Diagnostics.Append(refreshEmailParams(ctx, &data, definition.Params)...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is only called when smtp_password is set after an import. The API has no call to
+// update integrations and the integration already has its password, so only state changes.
+// Every other attribute requires replacement.
+func (r *emailIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data emailIntegrationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Recorded smtp_password of imported email integration")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *emailIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data emailIntegrationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete email integration: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted email integration resource")
+}
+
+// ImportState imports an existing email integration.
+// Import ID format: cluster_type/cluster_name/integration_id
+func (r *emailIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/integration_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	clusterType := parts[0]
+	clusterName := parts[1]
+	id := parts[2]
+
+	definition, err := r.client.GetIntegrationDefinition(ctx, clusterType, clusterName, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to get integration: %s", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Integration %s not found in %s/%s", id, clusterType, clusterName))
+		return
+	}
+	if !strings.EqualFold(definition.Type, emailIntegrationType) {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Integration %s is a %s integration, not email", id, definition.Type))
+		return
+	}
+
+	// The password isn't read back from the API, set smtp_password in the configuration after importing
+	data := emailIntegrationResourceData{
+		ClusterType:  types.StringValue(clusterType),
+		ClusterName:  types.StringValue(clusterName),
+		SmtpPort:     types.Int64Value(587),
+		SmtpPassword: types.StringNull(),
+		UseTLS:       types.BoolValue(true),
+		ID:           types.StringValue(id),
+	}
+	resp.Diagnostics.Append(refreshEmailParams(ctx, &data, definition.Params)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported email integration %s for %s/%s", id, clusterType, clusterName))
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testEmailIntegrationData(password types.String) emailIntegrationResourceData {
+	return emailIntegrationResourceData{
+		ClusterName:     types.StringValue("prod"),
+		ClusterType:     types.StringValue("cassandra"),
+		Name:            types.StringValue("ops-mail"),
+		SmtpHost:        types.StringValue("smtp.example.com"),
+		SmtpPort:        types.Int64Value(587),
+		SmtpUsername:    types.StringValue("alerts"),
+		SmtpPassword:    password,
+		FromAddress:     types.StringValue("alerts@example.com"),
+		ToAddresses:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ops@example.com")}),
+		UseTLS:          types.BoolValue(true),
+		SubjectTemplate: types.StringNull(),
+		ID:              types.StringValue("int-1"),
+	}
+}
+
+func TestRequiresReplaceIfPasswordChanged(t *testing.T) {
+	tests := []struct {
+		name  string
+		state types.String
+		plan  types.String
+		want  bool
+	}{
+		{"set after import", types.StringNull(), types.StringValue("secret"), false},
+		{"changed", types.StringValue("secret"), types.StringValue("rotated"), true},
+		{"removed", types.StringValue("secret"), types.StringNull(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{StateValue: tt.state, PlanValue: tt.plan}
+			var resp stringplanmodifier.RequiresReplaceIfFuncResponse
+			requiresReplaceIfPasswordChanged(context.Background(), req, &resp)
+
+			if resp.RequiresReplace != tt.want {
+				t.Errorf("requires replace = %v, want %v", resp.RequiresReplace, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmailIntegrationUpdateRecordsImportedPassword(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	r := &emailIntegrationResource{client: client}
+	s := resourceSchema(t, r)
+
+	prior := testEmailIntegrationData(types.StringNull())
+	planned := testEmailIntegrationData(types.StringValue("secret"))
+
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: s}}
+	r.Update(context.Background(), resource.UpdateRequest{
+		Plan:  newTestPlan(t, s, &planned),
+		State: newTestState(t, s, &prior),
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state emailIntegrationResourceData
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+	if state.SmtpPassword.ValueString() != "secret" || state.ID.ValueString() != "int-1" {
+		t.Errorf("smtp_password = %s, id = %s, want the password recorded on the same integration", state.SmtpPassword, state.ID)
+	}
+}
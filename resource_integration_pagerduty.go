@@ -0,0 +1,343 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*pagerdutyIntegrationResource)(nil)
+var _ resource.ResourceWithImportState = (*pagerdutyIntegrationResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*pagerdutyIntegrationResource)(nil)
+
+// pagerdutyIntegrationType is the integration type of PagerDuty in the API
+const pagerdutyIntegrationType = "pagerduty"
+
+// pagerdutyAlertSeverities are the AxonOps alert severities that severity_mapping can map
+var pagerdutyAlertSeverities = []string{"info", "warning", "error"}
+
+type pagerdutyIntegrationResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewIntegrationPagerDutyResource() resource.Resource {
+	return &pagerdutyIntegrationResource{}
+}
+
+func (r *pagerdutyIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *pagerdutyIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_pagerduty"
+}
+
+func (r *pagerdutyIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a PagerDuty integration that alerts can be routed to with axonops_alert_route. The AxonOps integrations API only creates, lists and deletes integrations and has no update call, so any change replaces the integration and gives it a new ID.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the integration, which axonops_alert_route uses as integration_name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"routing_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The integration key of the PagerDuty service (the routing key for Events API v2, the service key for v1).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"api_version": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("v2"),
+				Description: "The PagerDuty Events API version: v1, v2. Default: v2",
+				Validators: []validator.String{
+					stringvalidator.OneOf("v1", "v2"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "The monitoring host reported as the source of incidents. Events API v2 only.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"severity_mapping": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Maps AxonOps alert severities (info, warning, error) to PagerDuty severities (info, warning, error, critical), e.g. { error = \"critical\" }. Events API v2 only.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(pagerdutyAlertSeverities...)),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf("info", "warning", "error", "critical")),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the integration.",
+			},
+		},
+	}
+}
+
+type pagerdutyIntegrationResourceData struct {
+	ClusterName     types.String `tfsdk:"cluster_name"`
+	ClusterType     types.String `tfsdk:"cluster_type"`
+	Name            types.String `tfsdk:"name"`
+	RoutingKey      types.String `tfsdk:"routing_key"`
+	APIVersion      types.String `tfsdk:"api_version"`
+	Source          types.String `tfsdk:"source"`
+	SeverityMapping types.Map    `tfsdk:"severity_mapping"`
+	ID              types.String `tfsdk:"id"`
+}
+
+func (r *pagerdutyIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data pagerdutyIntegrationResourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.APIVersion.ValueString() != "v1" {
+		return
+	}
+
+	if !data.Source.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Unsupported Attribute", "source is only supported with api_version v2.")
+	}
+	if !data.SeverityMapping.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("severity_mapping"), "Unsupported Attribute", "severity_mapping is only supported with api_version v2.")
+	}
+}
+
+// pagerdutyParams converts the resource data to the params of the integration definition.
+// Each severity mapping is sent as its own severity_<level> param.
+func pagerdutyParams(ctx context.Context, data pagerdutyIntegrationResourceData) (map[string]string, error) {
+	params := map[string]string{
+		"name":        data.Name.ValueString(),
+		"routing_key": data.RoutingKey.ValueString(),
+		"api_version": data.APIVersion.ValueString(),
+	}
+
+	if !data.Source.IsNull() {
+		params["source"] = data.Source.ValueString()
+	}
+
+	if !data.SeverityMapping.IsNull() {
+		mapping := map[string]string{}
+		if diags := data.SeverityMapping.ElementsAs(ctx, &mapping, false); diags.HasError() {
+			return nil, fmt.Errorf("unable to read severity_mapping")
+		}
+		for severity, pagerdutySeverity := range mapping {
+			params["severity_"+severity] = pagerdutySeverity
+		}
+	}
+
+	return params, nil
+}
+
+// pagerdutySeverityMapping reads the severity_<level> params back into a map, null when none are set
+func pagerdutySeverityMapping(ctx context.Context, params map[string]string) (types.Map, diag.Diagnostics) {
+	mapping := map[string]string{}
+	for _, severity := range pagerdutyAlertSeverities {
+		if value, ok := params["severity_"+severity]; ok {
+			mapping[severity] = value
+		}
+	}
+	if len(mapping) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, mapping)
+}
+
+func (r *pagerdutyIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data pagerdutyIntegrationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := pagerdutyParams(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create PagerDuty integration: %s", err))
+		return
+	}
+
+	id, err := createIntegration(ctx, r.client, data.ClusterType.ValueString(), data.ClusterName.ValueString(), pagerdutyIntegrationType, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create PagerDuty integration: %s", err))
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	tflog.Info(ctx, "Created PagerDuty integration resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *pagerdutyIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data pagerdutyIntegrationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := r.client.GetIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integration: %s", err))
+		return
+	}
+
+	if definition == nil {
+		// Integration was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The routing key is a secret and stays as it is in state, the rest is refreshed from the API
+	data.Name = types.StringValue(definition.Params["name"])
+	if apiVersion, ok := definition.Params["api_version"]; ok {
+		data.APIVersion = types.StringValue(apiVersion)
+	}
+	if source, ok := definition.Params["source"]; ok {
+		data.Source = types.StringValue(source)
+	} else {
+		data.Source = types.StringNull()
+	}
+	data.SeverityMapping, diags = pagerdutySeverityMapping(ctx, definition.Params)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called: the API has no call to update integrations, so every attribute requires
+// replacement
+func (r *pagerdutyIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Update Not Supported", "PagerDuty integrations can't be updated in place, any change replaces the integration.")
+}
+
+func (r *pagerdutyIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data pagerdutyIntegrationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete PagerDuty integration: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted PagerDuty integration resource")
+}
+
+// ImportState imports an existing PagerDuty integration.
+// Import ID format: cluster_type/cluster_name/integration_id
+func (r *pagerdutyIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/integration_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	clusterType := parts[0]
+	clusterName := parts[1]
+	id := parts[2]
+
+	definition, err := r.client.GetIntegrationDefinition(ctx, clusterType, clusterName, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to get integration: %s", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Integration %s not found in %s/%s", id, clusterType, clusterName))
+		return
+	}
+	if !strings.EqualFold(definition.Type, pagerdutyIntegrationType) {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Integration %s is a %s integration, not PagerDuty", id, definition.Type))
+		return
+	}
+
+	apiVersion := definition.Params["api_version"]
+	if apiVersion == "" {
+		apiVersion = "v2"
+	}
+
+	severityMapping, diags := pagerdutySeverityMapping(ctx, definition.Params)
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), definition.Params["name"])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("routing_key"), definition.Params["routing_key"])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("api_version"), apiVersion)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("severity_mapping"), severityMapping)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if source, ok := definition.Params["source"]; ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source"), source)...)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Imported PagerDuty integration %s for %s/%s", id, clusterType, clusterName))
+}
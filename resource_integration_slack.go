@@ -0,0 +1,356 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*slackIntegrationResource)(nil)
+var _ resource.ResourceWithImportState = (*slackIntegrationResource)(nil)
+
+// slackIntegrationType is the integration type of Slack in the API
+const slackIntegrationType = "slack"
+
+type slackIntegrationResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewIntegrationSlackResource() resource.Resource {
+	return &slackIntegrationResource{}
+}
+
+func (r *slackIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *slackIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_slack"
+}
+
+func (r *slackIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Slack integration that alerts can be routed to with axonops_alert_route. The AxonOps integrations API only creates, lists and deletes integrations and has no update call, so any change replaces the integration and gives it a new ID.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the integration, which axonops_alert_route uses as integration_name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"webhook_url": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Slack incoming webhook URL.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https://`), "must be an https:// URL"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel": schema.StringAttribute{
+				Optional:    true,
+				Description: "The channel to post to (e.g., #ops-alerts), instead of the default channel of the webhook.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[#@][^\s,]+$`), "must be a channel name starting with # or a user starting with @"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name alerts are posted as.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"icon_emoji": schema.StringAttribute{
+				Optional:    true,
+				Description: "The emoji shown as the icon of alerts (e.g., :rotating_light:).",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^:[^:\s]+:$`), "must be an emoji code like :rotating_light:"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mention_users": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Slack user IDs (e.g., U024BE7LH) mentioned in alerts.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[^\s,]+$`), "must not contain spaces or commas")),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"mention_groups": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Slack user group IDs (e.g., S0614TZR7) mentioned in alerts.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[^\s,]+$`), "must not contain spaces or commas")),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the integration.",
+			},
+		},
+	}
+}
+
+type slackIntegrationResourceData struct {
+	ClusterName   types.String `tfsdk:"cluster_name"`
+	ClusterType   types.String `tfsdk:"cluster_type"`
+	Name          types.String `tfsdk:"name"`
+	WebhookURL    types.String `tfsdk:"webhook_url"`
+	Channel       types.String `tfsdk:"channel"`
+	Username      types.String `tfsdk:"username"`
+	IconEmoji     types.String `tfsdk:"icon_emoji"`
+	MentionUsers  types.List   `tfsdk:"mention_users"`
+	MentionGroups types.List   `tfsdk:"mention_groups"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// slackParams converts the resource data to the params of the integration definition.
+// Params only hold strings, so mentions are sent comma separated.
+func slackParams(ctx context.Context, data slackIntegrationResourceData) (map[string]string, error) {
+	params := map[string]string{
+		"name": data.Name.ValueString(),
+		"url":  data.WebhookURL.ValueString(),
+	}
+
+	optional := map[string]types.String{
+		"channel":    data.Channel,
+		"username":   data.Username,
+		"icon_emoji": data.IconEmoji,
+	}
+	for key, value := range optional {
+		if !value.IsNull() {
+			params[key] = value.ValueString()
+		}
+	}
+
+	mentions := map[string]types.List{
+		"mention_users":  data.MentionUsers,
+		"mention_groups": data.MentionGroups,
+	}
+	for key, value := range mentions {
+		if value.IsNull() {
+			continue
+		}
+		var ids []string
+		if diags := value.ElementsAs(ctx, &ids, false); diags.HasError() {
+			return nil, fmt.Errorf("unable to read %s", key)
+		}
+		params[key] = strings.Join(ids, ",")
+	}
+
+	return params, nil
+}
+
+// slackMentions converts a comma separated mentions param back to a list, null when it isn't set
+func slackMentions(ctx context.Context, params map[string]string, key string) (types.List, diag.Diagnostics) {
+	value := params[key]
+	if value == "" {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, strings.Split(value, ","))
+}
+
+// integrationParam returns an optional string param, null when it isn't set
+func integrationParam(params map[string]string, key string) types.String {
+	value, ok := params[key]
+	if !ok || value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func (r *slackIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data slackIntegrationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := slackParams(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Slack integration: %s", err))
+		return
+	}
+
+	id, err := createIntegration(ctx, r.client, data.ClusterType.ValueString(), data.ClusterName.ValueString(), slackIntegrationType, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Slack integration: %s", err))
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	tflog.Info(ctx, "Created Slack integration resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *slackIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data slackIntegrationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := r.client.GetIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integration: %s", err))
+		return
+	}
+
+	if definition == nil {
+		// Integration was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The webhook URL is a secret and stays as it is in state, the rest is refreshed from the API
+	data.Name = types.StringValue(definition.Params["name"])
+	data.Channel = integrationParam(definition.Params, "channel")
+	data.Username = integrationParam(definition.Params, "username")
+	data.IconEmoji = integrationParam(definition.Params, "icon_emoji")
+
+	data.MentionUsers, diags = slackMentions(ctx, definition.Params, "mention_users")
+	resp.Diagnostics.Append(diags...)
+	data.MentionGroups, diags = slackMentions(ctx, definition.Params, "mention_groups")
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called: the API has no call to update integrations, so every attribute requires
+// replacement
+func (r *slackIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Update Not Supported", "Slack integrations can't be updated in place, any change replaces the integration.")
+}
+
+func (r *slackIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data slackIntegrationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Slack integration: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Slack integration resource")
+}
+
+// ImportState imports an existing Slack integration.
+// Import ID format: cluster_type/cluster_name/integration_id
+func (r *slackIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/integration_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	clusterType := parts[0]
+	clusterName := parts[1]
+	id := parts[2]
+
+	definition, err := r.client.GetIntegrationDefinition(ctx, clusterType, clusterName, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to get integration: %s", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Integration %s not found in %s/%s", id, clusterType, clusterName))
+		return
+	}
+	if !strings.EqualFold(definition.Type, slackIntegrationType) {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Integration %s is a %s integration, not a Slack integration", id, definition.Type))
+		return
+	}
+
+	mentionUsers, diags := slackMentions(ctx, definition.Params, "mention_users")
+	resp.Diagnostics.Append(diags...)
+	mentionGroups, diags := slackMentions(ctx, definition.Params, "mention_groups")
+	resp.Diagnostics.Append(diags...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), definition.Params["name"])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("webhook_url"), definition.Params["url"])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel"), integrationParam(definition.Params, "channel"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), integrationParam(definition.Params, "username"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("icon_emoji"), integrationParam(definition.Params, "icon_emoji"))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mention_users"), mentionUsers)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mention_groups"), mentionGroups)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported Slack integration %s for %s/%s", id, clusterType, clusterName))
+}
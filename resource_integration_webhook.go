@@ -0,0 +1,310 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*webhookIntegrationResource)(nil)
+var _ resource.ResourceWithImportState = (*webhookIntegrationResource)(nil)
+
+// webhookIntegrationType is the integration type of webhooks in the API
+const webhookIntegrationType = "webhook"
+
+type webhookIntegrationResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewIntegrationWebhookResource() resource.Resource {
+	return &webhookIntegrationResource{}
+}
+
+func (r *webhookIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *webhookIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_webhook"
+}
+
+func (r *webhookIntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a webhook integration that alerts can be routed to with axonops_alert_route. The AxonOps integrations API only creates, lists and deletes integrations and has no update call, so any change replaces the webhook and gives it a new ID.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster type (cassandra, kafka, or dse).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the webhook, which axonops_alert_route uses as integration_name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The URL the webhook calls.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("POST"),
+				Description: "The HTTP method: GET, POST. Default: POST",
+				Validators: []validator.String{
+					stringvalidator.OneOf("GET", "POST"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Headers sent with each call, e.g. an Authorization header.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"body_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "Template of the request body. When omitted, AxonOps sends its default alert payload.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the integration.",
+			},
+		},
+	}
+}
+
+type webhookIntegrationResourceData struct {
+	ClusterName  types.String `tfsdk:"cluster_name"`
+	ClusterType  types.String `tfsdk:"cluster_type"`
+	Name         types.String `tfsdk:"name"`
+	URL          types.String `tfsdk:"url"`
+	Method       types.String `tfsdk:"method"`
+	Headers      types.Map    `tfsdk:"headers"`
+	BodyTemplate types.String `tfsdk:"body_template"`
+	ID           types.String `tfsdk:"id"`
+}
+
+// webhookParams converts the resource data to the params of the integration definition.
+// Params only hold strings, so headers are sent as a JSON object.
+func webhookParams(ctx context.Context, data webhookIntegrationResourceData) (map[string]string, error) {
+	params := map[string]string{
+		"name":   data.Name.ValueString(),
+		"url":    data.URL.ValueString(),
+		"method": data.Method.ValueString(),
+	}
+
+	if !data.Headers.IsNull() {
+		headers := map[string]string{}
+		if diags := data.Headers.ElementsAs(ctx, &headers, false); diags.HasError() {
+			return nil, fmt.Errorf("unable to read headers")
+		}
+		headersJson, err := json.Marshal(headers)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode headers: %w", err)
+		}
+		params["headers"] = string(headersJson)
+	}
+
+	if !data.BodyTemplate.IsNull() {
+		params["body"] = data.BodyTemplate.ValueString()
+	}
+
+	return params, nil
+}
+
+func (r *webhookIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data webhookIntegrationResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := webhookParams(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook integration: %s", err))
+		return
+	}
+
+	id, err := createIntegration(ctx, r.client, data.ClusterType.ValueString(), data.ClusterName.ValueString(), webhookIntegrationType, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook integration: %s", err))
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	tflog.Info(ctx, "Created webhook integration resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *webhookIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data webhookIntegrationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := r.client.GetIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integration: %s", err))
+		return
+	}
+
+	if definition == nil {
+		// Integration was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// url and headers may hold secrets and stay as they are in state, the rest is refreshed from the API
+	data.Name = types.StringValue(definition.Params["name"])
+	if method, ok := definition.Params["method"]; ok {
+		data.Method = types.StringValue(strings.ToUpper(method))
+	}
+	if body, ok := definition.Params["body"]; ok {
+		data.BodyTemplate = types.StringValue(body)
+	} else {
+		data.BodyTemplate = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called: the API has no call to update integrations, so every attribute requires
+// replacement
+func (r *webhookIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Update Not Supported", "Webhook integrations can't be updated in place, any change replaces the integration.")
+}
+
+func (r *webhookIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data webhookIntegrationResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteIntegrationDefinition(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook integration: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted webhook integration resource")
+}
+
+// ImportState imports an existing webhook integration.
+// Import ID format: cluster_type/cluster_name/integration_id
+func (r *webhookIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/integration_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	clusterType := parts[0]
+	clusterName := parts[1]
+	id := parts[2]
+
+	definition, err := r.client.GetIntegrationDefinition(ctx, clusterType, clusterName, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to get integration: %s", err))
+		return
+	}
+	if definition == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Integration %s not found in %s/%s", id, clusterType, clusterName))
+		return
+	}
+	if !strings.EqualFold(definition.Type, webhookIntegrationType) {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Integration %s is a %s integration, not a webhook", id, definition.Type))
+		return
+	}
+
+	method := strings.ToUpper(definition.Params["method"])
+	if method == "" {
+		method = "POST"
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), definition.Params["name"])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("url"), definition.Params["url"])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("method"), method)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	if headersJson, ok := definition.Params["headers"]; ok && headersJson != "" {
+		headers := map[string]string{}
+		if err := json.Unmarshal([]byte(headersJson), &headers); err != nil {
+			resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to decode headers of integration %s: %s", id, err))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("headers"), headers)...)
+	}
+	if body, ok := definition.Params["body"]; ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("body_template"), body)...)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Imported webhook integration %s for %s/%s", id, clusterType, clusterName))
+}
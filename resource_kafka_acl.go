@@ -7,10 +7,12 @@ import (
 
 	axonopsClient "terraform-provider-axonops/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +20,12 @@ import (
 var _ resource.Resource = (*aclResource)(nil)
 var _ resource.ResourceWithImportState = (*aclResource)(nil)
 
+// Operations and permission types accepted by Kafka, shared with the batch resource
+var (
+	kafkaACLOperations      = []string{"ANY", "ALL", "READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE", "CLUSTER_ACTION", "DESCRIBE_CONFIGS", "ALTER_CONFIGS", "IDEMPOTENT_WRITE", "CREATE_TOKENS", "DESCRIBE_TOKENS"}
+	kafkaACLPermissionTypes = []string{"ANY", "DENY", "ALLOW"}
+)
+
 type aclResource struct {
 	client *axonopsClient.AxonopsHttpClient
 }
@@ -84,10 +92,16 @@ func (r *aclResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 			"operation": schema.StringAttribute{
 				Required:    true,
 				Description: "The operation. Valid values: ANY, ALL, READ, WRITE, CREATE, DELETE, ALTER, DESCRIBE, CLUSTER_ACTION, DESCRIBE_CONFIGS, ALTER_CONFIGS, IDEMPOTENT_WRITE, CREATE_TOKENS, DESCRIBE_TOKENS.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(kafkaACLOperations...),
+				},
 			},
 			"permission_type": schema.StringAttribute{
 				Required:    true,
 				Description: "The permission type. Valid values: ANY, DENY, ALLOW.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(kafkaACLPermissionTypes...),
+				},
 			},
 		},
 	}
@@ -104,6 +118,50 @@ type aclResourceData struct {
 	PermissionType      types.String `tfsdk:"permission_type"`
 }
 
+// kafkaACL converts the resource data to the API representation
+func (d *aclResourceData) kafkaACL() axonopsClient.KafkaACL {
+	return axonopsClient.KafkaACL{
+		ResourceType:        d.ResourceType.ValueString(),
+		ResourceName:        d.ResourceName.ValueString(),
+		ResourcePatternType: d.ResourcePatternType.ValueString(),
+		Principal:           d.Principal.ValueString(),
+		Host:                d.Host.ValueString(),
+		Operation:           d.Operation.ValueString(),
+		PermissionType:      d.PermissionType.ValueString(),
+	}
+}
+
+// existsIn reports whether the ACL is present in an ACL listing.
+func (d *aclResourceData) existsIn(aclResponse *axonopsClient.ACLResponse) bool {
+	return aclExistsIn(aclResponse, d.kafkaACL())
+}
+
+// aclExistsIn reports whether an ACL is present in an ACL listing.
+// Kafka reports enum values in upper case, so they are compared case-insensitively.
+func aclExistsIn(aclResponse *axonopsClient.ACLResponse, want axonopsClient.KafkaACL) bool {
+	if aclResponse == nil {
+		return false
+	}
+
+	for _, res := range aclResponse.ACLResources {
+		if !strings.EqualFold(res.ResourceType, want.ResourceType) ||
+			res.ResourceName != want.ResourceName ||
+			!strings.EqualFold(res.ResourcePatternType, want.ResourcePatternType) {
+			continue
+		}
+		for _, acl := range res.ACLs {
+			if acl.Principal == want.Principal &&
+				acl.Host == want.Host &&
+				strings.EqualFold(acl.Operation, want.Operation) &&
+				strings.EqualFold(acl.PermissionType, want.PermissionType) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data aclResourceData
 
@@ -124,7 +182,7 @@ func (r *aclResource) Create(ctx context.Context, req resource.CreateRequest, re
 		PermissionType:      data.PermissionType.ValueString(),
 	}
 
-	err := r.client.CreateACL(data.ClusterName.ValueString(), acl)
+	err := r.client.CreateACL(ctx, data.ClusterName.ValueString(), acl)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ACL, got error: %s", err))
 		return
@@ -146,8 +204,18 @@ func (r *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	// ACLs don't have a unique identifier for individual reads via API
-	// We keep the state as-is since Kafka ACLs are matched by all fields
+	// ACLs don't have a unique identifier, so look for one matching all fields
+	aclResponse, err := r.client.GetACLs(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACLs, got error: %s", err))
+		return
+	}
+
+	if !data.existsIn(aclResponse) {
+		// ACL was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -182,7 +250,7 @@ func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		PermissionType:      stateData.PermissionType.ValueString(),
 	}
 
-	err := r.client.DeleteACL(stateData.ClusterName.ValueString(), oldACL)
+	err := r.client.DeleteACL(ctx, stateData.ClusterName.ValueString(), oldACL)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete old ACL during update, got error: %s", err))
 		return
@@ -198,7 +266,7 @@ func (r *aclResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		PermissionType:      planData.PermissionType.ValueString(),
 	}
 
-	err = r.client.CreateACL(planData.ClusterName.ValueString(), newACL)
+	err = r.client.CreateACL(ctx, planData.ClusterName.ValueString(), newACL)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create new ACL during update, got error: %s", err))
 		return
@@ -230,7 +298,7 @@ func (r *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		PermissionType:      data.PermissionType.ValueString(),
 	}
 
-	err := r.client.DeleteACL(data.ClusterName.ValueString(), acl)
+	err := r.client.DeleteACL(ctx, data.ClusterName.ValueString(), acl)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ACL, got error: %s", err))
 		return
@@ -0,0 +1,422 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*aclBatchResource)(nil)
+var _ resource.ResourceWithImportState = (*aclBatchResource)(nil)
+
+// aclRuleAttrTypes describes a single rules element
+var aclRuleAttrTypes = map[string]attr.Type{
+	"resource_type":         types.StringType,
+	"resource_name":         types.StringType,
+	"resource_pattern_type": types.StringType,
+	"host":                  types.StringType,
+	"operation":             types.StringType,
+	"permission_type":       types.StringType,
+}
+
+type aclBatchResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaACLBatchResource() resource.Resource {
+	return &aclBatchResource{}
+}
+
+func (r *aclBatchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *aclBatchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_acl_batch"
+}
+
+func (r *aclBatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of Kafka ACL entries for a single principal in one resource.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"principal": schema.StringAttribute{
+				Required:    true,
+				Description: "The principal the rules apply to (e.g., User:alice).",
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The ACL entries granted to or denied from the principal.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of resource. Valid values: ANY, TOPIC, GROUP, CLUSTER, TRANSACTIONAL_ID, DELEGATION_TOKEN, USER.",
+						},
+						"resource_name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the resource.",
+						},
+						"resource_pattern_type": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("LITERAL"),
+							Description: "The pattern type. Valid values: ANY, MATCH, LITERAL, PREFIXED. Default: LITERAL.",
+						},
+						"host": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("*"),
+							Description: "The host. Default: * (all hosts).",
+						},
+						"operation": schema.StringAttribute{
+							Required:    true,
+							Description: "The operation. Valid values: ANY, ALL, READ, WRITE, CREATE, DELETE, ALTER, DESCRIBE, CLUSTER_ACTION, DESCRIBE_CONFIGS, ALTER_CONFIGS, IDEMPOTENT_WRITE, CREATE_TOKENS, DESCRIBE_TOKENS.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(kafkaACLOperations...),
+							},
+						},
+						"permission_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The permission type. Valid values: ANY, DENY, ALLOW.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(kafkaACLPermissionTypes...),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type aclBatchResourceData struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Principal   types.String `tfsdk:"principal"`
+	Rules       types.List   `tfsdk:"rules"`
+}
+
+type aclRuleData struct {
+	ResourceType        types.String `tfsdk:"resource_type"`
+	ResourceName        types.String `tfsdk:"resource_name"`
+	ResourcePatternType types.String `tfsdk:"resource_pattern_type"`
+	Host                types.String `tfsdk:"host"`
+	Operation           types.String `tfsdk:"operation"`
+	PermissionType      types.String `tfsdk:"permission_type"`
+}
+
+// buildACLRules converts the rules attribute to API ACLs for the principal
+func buildACLRules(ctx context.Context, principal string, list types.List) ([]axonopsClient.KafkaACL, diag.Diagnostics) {
+	var rules []aclRuleData
+
+	diags := list.ElementsAs(ctx, &rules, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var result []axonopsClient.KafkaACL
+	for _, rule := range rules {
+		result = append(result, axonopsClient.KafkaACL{
+			ResourceType:        rule.ResourceType.ValueString(),
+			ResourceName:        rule.ResourceName.ValueString(),
+			ResourcePatternType: rule.ResourcePatternType.ValueString(),
+			Principal:           principal,
+			Host:                rule.Host.ValueString(),
+			Operation:           rule.Operation.ValueString(),
+			PermissionType:      rule.PermissionType.ValueString(),
+		})
+	}
+
+	return result, diags
+}
+
+// flattenACLRules converts API ACLs to the rules attribute
+func flattenACLRules(ctx context.Context, acls []axonopsClient.KafkaACL) (types.List, diag.Diagnostics) {
+	elements := []aclRuleData{}
+	for _, acl := range acls {
+		elements = append(elements, aclRuleData{
+			ResourceType:        types.StringValue(acl.ResourceType),
+			ResourceName:        types.StringValue(acl.ResourceName),
+			ResourcePatternType: types.StringValue(acl.ResourcePatternType),
+			Host:                types.StringValue(acl.Host),
+			Operation:           types.StringValue(acl.Operation),
+			PermissionType:      types.StringValue(acl.PermissionType),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: aclRuleAttrTypes}, elements)
+}
+
+// subtractACLs returns the ACLs in from that are not in remove
+func subtractACLs(from, remove []axonopsClient.KafkaACL) []axonopsClient.KafkaACL {
+	removed := make(map[axonopsClient.KafkaACL]bool)
+	for _, acl := range remove {
+		removed[acl] = true
+	}
+
+	var result []axonopsClient.KafkaACL
+	for _, acl := range from {
+		if !removed[acl] {
+			result = append(result, acl)
+		}
+	}
+	return result
+}
+
+// createACLs creates each ACL in turn, returning the ones created before any failure
+func (r *aclBatchResource) createACLs(ctx context.Context, clusterName string, acls []axonopsClient.KafkaACL) ([]axonopsClient.KafkaACL, error) {
+	var created []axonopsClient.KafkaACL
+	for _, acl := range acls {
+		if err := r.client.CreateACL(ctx, clusterName, acl); err != nil {
+			return created, fmt.Errorf("%s %s on %s %s: %w", acl.PermissionType, acl.Operation, acl.ResourceType, acl.ResourceName, err)
+		}
+		created = append(created, acl)
+	}
+	return created, nil
+}
+
+// deleteACLs deletes each ACL in turn
+func (r *aclBatchResource) deleteACLs(ctx context.Context, clusterName string, acls []axonopsClient.KafkaACL) error {
+	for _, acl := range acls {
+		if err := r.client.DeleteACL(ctx, clusterName, acl); err != nil {
+			return fmt.Errorf("%s %s on %s %s: %w", acl.PermissionType, acl.Operation, acl.ResourceType, acl.ResourceName, err)
+		}
+	}
+	return nil
+}
+
+func (r *aclBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data aclBatchResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acls, diags := buildACLRules(ctx, data.Principal.ValueString(), data.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.createACLs(ctx, data.ClusterName.ValueString(), acls)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ACL, got error: %s", err))
+
+		// Keep track of the ACLs that were created so they are cleaned up
+		if len(created) > 0 {
+			data.Rules, diags = flattenACLRules(ctx, created)
+			resp.Diagnostics.Append(diags...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created %d ACLs for %s", len(created), data.Principal.ValueString()))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *aclBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data aclBatchResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acls, diags := buildACLRules(ctx, data.Principal.ValueString(), data.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aclResponse, err := r.client.GetACLs(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACLs, got error: %s", err))
+		return
+	}
+
+	// Drop rules deleted outside of Terraform so the next apply recreates them
+	var remaining []axonopsClient.KafkaACL
+	for _, acl := range acls {
+		if aclExistsIn(aclResponse, acl) {
+			remaining = append(remaining, acl)
+		}
+	}
+
+	if len(remaining) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if len(remaining) != len(acls) {
+		data.Rules, diags = flattenACLRules(ctx, remaining)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *aclBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planData aclBatchResourceData
+	var stateData aclBatchResourceData
+
+	diags := req.Plan.Get(ctx, &planData)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &stateData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planACLs, diags := buildACLRules(ctx, planData.Principal.ValueString(), planData.Rules)
+	resp.Diagnostics.Append(diags...)
+	stateACLs, diags := buildACLRules(ctx, stateData.Principal.ValueString(), stateData.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only touch the rules that changed, unless the ACLs moved to another cluster
+	toDelete := stateACLs
+	toCreate := planACLs
+	if planData.ClusterName.ValueString() == stateData.ClusterName.ValueString() {
+		toDelete = subtractACLs(stateACLs, planACLs)
+		toCreate = subtractACLs(planACLs, stateACLs)
+	}
+
+	err := r.deleteACLs(ctx, stateData.ClusterName.ValueString(), toDelete)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete old ACL during update, got error: %s", err))
+		return
+	}
+
+	_, err = r.createACLs(ctx, planData.ClusterName.ValueString(), toCreate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create new ACL during update, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated ACLs for %s: %d removed, %d added", planData.Principal.ValueString(), len(toDelete), len(toCreate)))
+
+	diags = resp.State.Set(ctx, &planData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *aclBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data aclBatchResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acls, diags := buildACLRules(ctx, data.Principal.ValueString(), data.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.deleteACLs(ctx, data.ClusterName.ValueString(), acls)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ACL, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted %d ACLs for %s", len(acls), data.Principal.ValueString()))
+}
+
+// ImportState imports every ACL of a principal.
+// Import ID format: cluster_name/principal
+func (r *aclBatchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterName, principal, found := strings.Cut(req.ID, "/")
+	if !found || clusterName == "" || principal == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_name/principal, got: %s", req.ID),
+		)
+		return
+	}
+
+	aclResponse, err := r.client.GetACLs(ctx, clusterName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read ACLs: %s", err))
+		return
+	}
+
+	var acls []axonopsClient.KafkaACL
+	for _, res := range aclResponse.ACLResources {
+		for _, acl := range res.ACLs {
+			if acl.Principal != principal {
+				continue
+			}
+			acls = append(acls, axonopsClient.KafkaACL{
+				ResourceType:        res.ResourceType,
+				ResourceName:        res.ResourceName,
+				ResourcePatternType: res.ResourcePatternType,
+				Principal:           acl.Principal,
+				Host:                acl.Host,
+				Operation:           acl.Operation,
+				PermissionType:      acl.PermissionType,
+			})
+		}
+	}
+
+	if len(acls) == 0 {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("No ACLs found for principal %s in cluster %s", principal, clusterName))
+		return
+	}
+
+	rules, diags := flattenACLRules(ctx, acls)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := aclBatchResourceData{
+		ClusterName: types.StringValue(clusterName),
+		Principal:   types.StringValue(principal),
+		Rules:       rules,
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported %d ACLs for %s from cluster %s", len(acls), principal, clusterName))
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*kafkaBrokerConfigResource)(nil)
+var _ resource.ResourceWithImportState = (*kafkaBrokerConfigResource)(nil)
+
+type kafkaBrokerConfigResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaBrokerConfigResource() resource.Resource {
+	return &kafkaBrokerConfigResource{}
+}
+
+func (r *kafkaBrokerConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *kafkaBrokerConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_broker_config"
+}
+
+func (r *kafkaBrokerConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the cluster-wide dynamic broker configs of a Kafka cluster. Only the configs in the config map are managed, others set outside of Terraform are left alone. Use a single resource per cluster.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The dynamic broker configs keyed by name (e.g., log.retention.hours, num.io.threads). Removed configs are reset to the broker default.",
+			},
+		},
+	}
+}
+
+type kafkaBrokerConfigResourceData struct {
+	ClusterName types.String            `tfsdk:"cluster_name"`
+	Config      map[string]types.String `tfsdk:"config"`
+}
+
+// configMapChanges returns the updates turning the prior configs into the planned ones.
+// Shared by the resources managing dynamic Kafka configs as a map.
+func configMapChanges(prior, planned map[string]types.String) []axonopsClient.KafkaUpdateTopicConfig {
+	var changes []axonopsClient.KafkaUpdateTopicConfig
+	for key, value := range planned {
+		if priorValue, ok := prior[key]; ok && priorValue.Equal(value) {
+			continue
+		}
+		changes = append(changes, axonopsClient.KafkaUpdateTopicConfig{Key: key, Value: value.ValueString(), Op: "SET"})
+	}
+	for key := range prior {
+		if _, ok := planned[key]; !ok {
+			changes = append(changes, axonopsClient.KafkaUpdateTopicConfig{Key: key, Op: "DELETE"})
+		}
+	}
+	return changes
+}
+
+func (r *kafkaBrokerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data kafkaBrokerConfigResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes := configMapChanges(nil, data.Config)
+	if len(changes) > 0 {
+		err := r.client.UpdateBrokerConfig(ctx, data.ClusterName.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set broker configs: %s", err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Created kafka broker config resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *kafkaBrokerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data kafkaBrokerConfigResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetBrokerConfigs(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read broker configs: %s", err))
+		return
+	}
+
+	// Only refresh the managed configs, a config removed outside of Terraform
+	// drops out of state so the next plan sets it again
+	config := make(map[string]types.String)
+	for key := range data.Config {
+		if value, ok := current[key]; ok {
+			config[key] = types.StringValue(value)
+		}
+	}
+	data.Config = config
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *kafkaBrokerConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planData kafkaBrokerConfigResourceData
+	var stateData kafkaBrokerConfigResourceData
+
+	diags := req.Plan.Get(ctx, &planData)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &stateData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes := configMapChanges(stateData.Config, planData.Config)
+	if len(changes) > 0 {
+		err := r.client.UpdateBrokerConfig(ctx, planData.ClusterName.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update broker configs: %s", err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Updated kafka broker config resource")
+
+	diags = resp.State.Set(ctx, &planData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *kafkaBrokerConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data kafkaBrokerConfigResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reset the managed configs to the broker defaults
+	changes := configMapChanges(data.Config, nil)
+	if len(changes) > 0 {
+		err := r.client.UpdateBrokerConfig(ctx, data.ClusterName.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset broker configs: %s", err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Deleted kafka broker config resource")
+}
+
+// ImportState imports the dynamic broker configs of a cluster.
+// Import ID format: cluster_name
+func (r *kafkaBrokerConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	current, err := r.client.GetBrokerConfigs(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read broker configs: %s", err))
+		return
+	}
+
+	config := make(map[string]types.String)
+	for key, value := range current {
+		config[key] = types.StringValue(value)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config"), config)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported broker configs from cluster %s", req.ID))
+}
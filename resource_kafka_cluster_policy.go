@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*kafkaClusterPolicyResource)(nil)
+var _ resource.ResourceWithImportState = (*kafkaClusterPolicyResource)(nil)
+
+type kafkaClusterPolicyResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaClusterPolicyResource() resource.Resource {
+	return &kafkaClusterPolicyResource{}
+}
+
+func (r *kafkaClusterPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *kafkaClusterPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_cluster_policy"
+}
+
+func (r *kafkaClusterPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages cluster-wide governance policies for Kafka topics. axonops_kafka_topic and axonops_kafka_topic_acl resources are checked against the policy when they are created, deleted or their partitions are increased. If the policy can't be read, topics are managed without it and a warning is shown.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"prevent_topic_deletion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether topics in the cluster are protected from deletion. Default: false",
+			},
+			"require_config_keys": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Description: "Kafka config keys every new topic must set (e.g., retention.ms).",
+			},
+			"max_partition_count": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Maximum number of partitions for a new topic. 0 means no limit. Default: 0",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_replication_factor": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Minimum replication factor for a new topic. 0 means no minimum. Default: 0",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+type kafkaClusterPolicyResourceData struct {
+	ClusterName          types.String `tfsdk:"cluster_name"`
+	PreventTopicDeletion types.Bool   `tfsdk:"prevent_topic_deletion"`
+	RequireConfigKeys    types.List   `tfsdk:"require_config_keys"`
+	MaxPartitionCount    types.Int64  `tfsdk:"max_partition_count"`
+	MinReplicationFactor types.Int64  `tfsdk:"min_replication_factor"`
+}
+
+func (r *kafkaClusterPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data kafkaClusterPolicyResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireConfigKeys := []string{}
+	diags = data.RequireConfigKeys.ElementsAs(ctx, &requireConfigKeys, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy := axonopsClient.KafkaClusterPolicy{
+		PreventTopicDeletion: data.PreventTopicDeletion.ValueBool(),
+		RequireConfigKeys:    requireConfigKeys,
+		MaxPartitionCount:    int(data.MaxPartitionCount.ValueInt64()),
+		MinReplicationFactor: int(data.MinReplicationFactor.ValueInt64()),
+	}
+
+	err := r.client.UpdateKafkaClusterPolicy(ctx, data.ClusterName.ValueString(), policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cluster policy: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Created Kafka cluster policy resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *kafkaClusterPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data kafkaClusterPolicyResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.client.GetKafkaClusterPolicy(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster policy: %s", err))
+		return
+	}
+
+	if policy == nil {
+		// Policy was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.PreventTopicDeletion = types.BoolValue(policy.PreventTopicDeletion)
+	data.MaxPartitionCount = types.Int64Value(int64(policy.MaxPartitionCount))
+	data.MinReplicationFactor = types.Int64Value(int64(policy.MinReplicationFactor))
+
+	if policy.RequireConfigKeys == nil {
+		policy.RequireConfigKeys = []string{}
+	}
+	data.RequireConfigKeys, diags = types.ListValueFrom(ctx, types.StringType, policy.RequireConfigKeys)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *kafkaClusterPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, stateData kafkaClusterPolicyResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &stateData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireConfigKeys := []string{}
+	diags = data.RequireConfigKeys.ElementsAs(ctx, &requireConfigKeys, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy := axonopsClient.KafkaClusterPolicy{
+		PreventTopicDeletion: data.PreventTopicDeletion.ValueBool(),
+		RequireConfigKeys:    requireConfigKeys,
+		MaxPartitionCount:    int(data.MaxPartitionCount.ValueInt64()),
+		MinReplicationFactor: int(data.MinReplicationFactor.ValueInt64()),
+	}
+
+	err := r.client.UpdateKafkaClusterPolicy(ctx, data.ClusterName.ValueString(), policy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update cluster policy: %s", err))
+		return
+	}
+
+	// Remove the policy from the previous cluster when the resource moved
+	if stateData.ClusterName.ValueString() != data.ClusterName.ValueString() {
+		err = r.client.DeleteKafkaClusterPolicy(ctx, stateData.ClusterName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to remove cluster policy from cluster %s: %s", stateData.ClusterName.ValueString(), err))
+		}
+	}
+
+	tflog.Info(ctx, "Updated Kafka cluster policy resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *kafkaClusterPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data kafkaClusterPolicyResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteKafkaClusterPolicy(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cluster policy: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Kafka cluster policy resource")
+}
+
+// ImportState imports an existing cluster policy.
+// Import ID format: cluster_name
+func (r *kafkaClusterPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterName := req.ID
+
+	policy, err := r.client.GetKafkaClusterPolicy(ctx, clusterName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read cluster policy: %s", err))
+		return
+	}
+
+	if policy == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("No cluster policy found for cluster %s", clusterName))
+		return
+	}
+
+	requireConfigKeys := policy.RequireConfigKeys
+	if requireConfigKeys == nil {
+		requireConfigKeys = []string{}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prevent_topic_deletion"), policy.PreventTopicDeletion)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("require_config_keys"), requireConfigKeys)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("max_partition_count"), int64(policy.MaxPartitionCount))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("min_replication_factor"), int64(policy.MinReplicationFactor))...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported Kafka cluster policy for cluster %s", clusterName))
+}
@@ -2,14 +2,20 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	axonopsClient "terraform-provider-axonops/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -62,7 +68,10 @@ func (r *connectorResource) Schema(ctx context.Context, req resource.SchemaReque
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
-				Description: "The name of the connector.",
+				Description: "The name of the connector. Changing it recreates the connector.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"config": schema.MapAttribute{
 				Required:    true,
@@ -73,16 +82,182 @@ func (r *connectorResource) Schema(ctx context.Context, req resource.SchemaReque
 				Computed:    true,
 				Description: "The type of the connector (source or sink).",
 			},
+			"config_drift": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Config keys whose running value differs from the last applied value, formatted as \"desired=X, actual=Y\". Informational only.",
+				PlanModifiers: []planmodifier.Map{
+					configDriftPlanModifier{},
+				},
+			},
+			"restart_on_config_update": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to restart the connector after its config changes, so it picks up the new settings. Default: false",
+			},
+			"last_config_update": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the connector config was last updated, as reported by AxonOps.",
+			},
+			"paused": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the connector is paused, e.g. during maintenance. The connector and its config are kept while paused. Default: false",
+			},
 		},
 	}
 }
 
 type connectorResourceData struct {
-	ClusterName        types.String            `tfsdk:"cluster_name"`
-	ConnectClusterName types.String            `tfsdk:"connect_cluster_name"`
-	Name               types.String            `tfsdk:"name"`
-	Config             map[string]types.String `tfsdk:"config"`
-	Type               types.String            `tfsdk:"type"`
+	ClusterName        types.String `tfsdk:"cluster_name"`
+	ConnectClusterName types.String `tfsdk:"connect_cluster_name"`
+	Name               types.String `tfsdk:"name"`
+	Config             types.Map    `tfsdk:"config"`
+	Type               types.String `tfsdk:"type"`
+	ConfigDrift        types.Map    `tfsdk:"config_drift"`
+
+	RestartOnConfigUpdate types.Bool   `tfsdk:"restart_on_config_update"`
+	LastConfigUpdate      types.String `tfsdk:"last_config_update"`
+	Paused                types.Bool   `tfsdk:"paused"`
+}
+
+// setPaused pauses or resumes the connector
+func (r *connectorResource) setPaused(ctx context.Context, data connectorResourceData, paused bool) error {
+	if paused {
+		return r.client.PauseConnector(ctx, data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
+	}
+	return r.client.ResumeConnector(ctx, data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
+}
+
+// applyUpdate updates the connector config, then restarts and pauses or resumes the
+// connector as the plan requires
+func (r *connectorResource) applyUpdate(ctx context.Context, planData, stateData connectorResourceData, config map[string]string) (*axonopsClient.KafkaConnectorResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	result, err := r.client.UpdateConnectorConfig(ctx, planData.ClusterName.ValueString(), planData.ConnectClusterName.ValueString(), planData.Name.ValueString(), config)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update connector, got error: %s", err))
+		return nil, diags
+	}
+
+	// Restart only when the config itself changed
+	if planData.RestartOnConfigUpdate.ValueBool() && !planData.Config.Equal(stateData.Config) {
+		err = r.client.RestartConnector(ctx, planData.ClusterName.ValueString(), planData.ConnectClusterName.ValueString(), planData.Name.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Connector config was updated but the connector could not be restarted, got error: %s", err))
+			return nil, diags
+		}
+		tflog.Info(ctx, "Restarted connector after config update")
+	}
+
+	if planData.Paused.ValueBool() != stateData.Paused.ValueBool() {
+		err = r.setPaused(ctx, planData, planData.Paused.ValueBool())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Connector config was updated but the connector could not be paused or resumed, got error: %s", err))
+			return nil, diags
+		}
+	}
+
+	return result, diags
+}
+
+// connectorConfigDrift compares the desired config with the running config and
+// describes every key that differs. The "name" key added by Kafka Connect is ignored.
+func connectorConfigDrift(desired, actual map[string]string) map[string]string {
+	drift := make(map[string]string)
+	actual = axonopsClient.FilterConnectorConfig(actual)
+
+	for key, value := range desired {
+		actualValue, ok := actual[key]
+		if !ok {
+			drift[key] = fmt.Sprintf("desired=%s, actual=<unset>", value)
+		} else if actualValue != value {
+			drift[key] = fmt.Sprintf("desired=%s, actual=%s", value, actualValue)
+		}
+	}
+
+	for key, actualValue := range actual {
+		if _, ok := desired[key]; !ok {
+			drift[key] = fmt.Sprintf("desired=<unset>, actual=%s", actualValue)
+		}
+	}
+
+	return drift
+}
+
+// connectorAppliedConfigKey is the private state key holding the config last applied by
+// Terraform. Read replaces config with the running config, so drift is measured against this.
+const connectorAppliedConfigKey = "applied_config"
+
+// privateState is implemented by the private state of resource requests and responses
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setAppliedConfig records the config applied by Terraform in private state
+func setAppliedConfig(ctx context.Context, private privateState, config map[string]string) diag.Diagnostics {
+	value, err := json.Marshal(config)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode connector config: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, connectorAppliedConfigKey, value)
+}
+
+// appliedConfig returns the config last applied by Terraform. State written before the
+// applied config was recorded falls back to the config in state.
+func appliedConfig(ctx context.Context, private privateState, stateConfig types.Map) (map[string]string, diag.Diagnostics) {
+	var config map[string]string
+
+	applied, diags := private.GetKey(ctx, connectorAppliedConfigKey)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if applied == nil {
+		diags.Append(stateConfig.ElementsAs(ctx, &config, false)...)
+		return config, diags
+	}
+
+	if err := json.Unmarshal(applied, &config); err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to decode the applied connector config: %s", err))
+	}
+	return config, diags
+}
+
+// configDriftPlanModifier keeps the prior config_drift while config is unchanged, since drift
+// is only recomputed by Read. A config change is applied as configured and clears the drift.
+type configDriftPlanModifier struct{}
+
+func (m configDriftPlanModifier) Description(_ context.Context) string {
+	return "Keeps the prior config drift unless config changes."
+}
+
+func (m configDriftPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m configDriftPlanModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planConfig, stateConfig types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("config"), &planConfig)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("config"), &stateConfig)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planConfig.Equal(stateConfig) {
+		resp.PlanValue = req.StateValue
+	} else if !planConfig.IsUnknown() {
+		resp.PlanValue = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
 }
 
 func (r *connectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -95,10 +270,11 @@ func (r *connectorResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	// Convert config map
-	config := make(map[string]string)
-	for key, value := range data.Config {
-		config[key] = value.ValueString()
+	var config map[string]string
+	diags = data.Config.ElementsAs(ctx, &config, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	connector := axonopsClient.KafkaConnector{
@@ -106,14 +282,26 @@ func (r *connectorResource) Create(ctx context.Context, req resource.CreateReque
 		Config: config,
 	}
 
-	result, err := r.client.CreateConnector(data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), connector)
+	result, err := r.client.CreateConnector(ctx, data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), connector)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create connector, got error: %s", err))
 		return
 	}
 
+	if data.Paused.ValueBool() {
+		err = r.setPaused(ctx, data, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Connector was created but could not be paused, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(setAppliedConfig(ctx, resp.Private, config)...)
+
 	// Update computed fields
 	data.Type = types.StringValue(result.Type)
+	data.ConfigDrift = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	data.LastConfigUpdate = types.StringValue(result.LastConfigUpdate)
 
 	tflog.Info(ctx, "Created connector resource")
 
@@ -131,7 +319,7 @@ func (r *connectorResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	result, err := r.client.GetConnector(data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
+	result, err := r.client.GetConnector(ctx, data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connector, got error: %s", err))
 		return
@@ -143,17 +331,30 @@ func (r *connectorResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Update state with current config from API
-	// Filter out "name" key as it's automatically added by Kafka Connect
-	config := make(map[string]types.String)
-	for key, value := range result.Config {
-		if key == "name" {
-			continue
-		}
-		config[key] = types.StringValue(value)
+	// Record drift between the last applied config and the running config
+	desired, diags := appliedConfig(ctx, req.Private, data.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	data.Config = config
+
+	data.ConfigDrift, diags = types.MapValueFrom(ctx, types.StringType, connectorConfigDrift(desired, result.Config))
+	resp.Diagnostics.Append(diags...)
+
+	// Update state with current config from API
+	data.Config, diags = types.MapValueFrom(ctx, types.StringType, axonopsClient.FilterConnectorConfig(result.Config))
+	resp.Diagnostics.Append(diags...)
 	data.Type = types.StringValue(result.Type)
+	data.LastConfigUpdate = types.StringValue(result.LastConfigUpdate)
+
+	status, err := r.client.GetConnectorStatus(ctx, data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connector status, got error: %s", err))
+		return
+	}
+	if status != nil {
+		data.Paused = types.BoolValue(status.Connector.State == "PAUSED")
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -177,27 +378,34 @@ func (r *connectorResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// Check if name changed - requires delete and recreate
+	// Name changes are planned as a replacement, this only guards against a plan that slipped through
 	if planData.Name.ValueString() != stateData.Name.ValueString() {
 		resp.Diagnostics.AddError("Cannot Change Connector Name",
 			"Changing the connector name requires destroying and recreating the resource. Use 'terraform taint' or modify lifecycle settings.")
 		return
 	}
 
-	// Convert config map
-	config := make(map[string]string)
-	for key, value := range planData.Config {
-		config[key] = value.ValueString()
+	var config map[string]string
+	diags = planData.Config.ElementsAs(ctx, &config, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	result, err := r.client.UpdateConnectorConfig(planData.ClusterName.ValueString(), planData.ConnectClusterName.ValueString(), planData.Name.ValueString(), config)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update connector, got error: %s", err))
+	result, diags := r.applyUpdate(ctx, planData, stateData, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Update computed fields
+	resp.Diagnostics.Append(setAppliedConfig(ctx, resp.Private, config)...)
+
+	// Update computed fields. Drift planned from state is kept until the next refresh.
 	planData.Type = types.StringValue(result.Type)
+	if planData.ConfigDrift.IsUnknown() {
+		planData.ConfigDrift = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	planData.LastConfigUpdate = types.StringValue(result.LastConfigUpdate)
 
 	tflog.Info(ctx, "Updated connector resource")
 
@@ -215,7 +423,7 @@ func (r *connectorResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.client.DeleteConnector(data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
+	err := r.client.DeleteConnector(ctx, data.ClusterName.ValueString(), data.ConnectClusterName.ValueString(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete connector, got error: %s", err))
 		return
@@ -242,7 +450,7 @@ func (r *connectorResource) ImportState(ctx context.Context, req resource.Import
 	connectorName := parts[2]
 
 	// Get connector details from the API
-	connector, err := r.client.GetConnector(clusterName, connectClusterName, connectorName)
+	connector, err := r.client.GetConnector(ctx, clusterName, connectClusterName, connectorName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
@@ -265,15 +473,17 @@ func (r *connectorResource) ImportState(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), connectorName)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), connector.Type)...)
 
-	// Filter out "name" key from config
-	config := make(map[string]string)
-	for key, value := range connector.Config {
-		if key == "name" {
-			continue
-		}
-		config[key] = value
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config"), axonopsClient.FilterConnectorConfig(connector.Config))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config_drift"), map[string]string{})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restart_on_config_update"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("last_config_update"), connector.LastConfigUpdate)...)
+
+	status, err := r.client.GetConnectorStatus(ctx, clusterName, connectClusterName, connectorName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read status of connector %s: %s", connectorName, err))
+		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config"), config)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("paused"), status != nil && status.Connector.State == "PAUSED")...)
 
 	tflog.Info(ctx, fmt.Sprintf("Imported connector %s from cluster %s/%s", connectorName, clusterName, connectClusterName))
 }
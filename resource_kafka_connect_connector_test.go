@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakePrivateState keeps private state keys in memory
+type fakePrivateState map[string][]byte
+
+func (p fakePrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p fakePrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func testConnectorConfig(config map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(config))
+	for key, value := range config {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func testConnectorData(config, drift map[string]string) connectorResourceData {
+	return connectorResourceData{
+		ClusterName:           types.StringValue("prod"),
+		ConnectClusterName:    types.StringValue("connect"),
+		Name:                  types.StringValue("orders-sink"),
+		Config:                testConnectorConfig(config),
+		Type:                  types.StringValue("sink"),
+		ConfigDrift:           testConnectorConfig(drift),
+		RestartOnConfigUpdate: types.BoolValue(false),
+		LastConfigUpdate:      types.StringValue("2024-01-01T00:00:00Z"),
+		Paused:                types.BoolValue(false),
+	}
+}
+
+func TestConnectorConfigDrift(t *testing.T) {
+	desired := map[string]string{
+		"tasks.max": "2",
+		"topics":    "orders",
+		"batch":     "100",
+	}
+	actual := map[string]string{
+		"name":      "orders-sink",
+		"tasks.max": "4",
+		"topics":    "orders",
+		"linger.ms": "5",
+	}
+
+	want := map[string]string{
+		"tasks.max": "desired=2, actual=4",
+		"batch":     "desired=100, actual=<unset>",
+		"linger.ms": "desired=<unset>, actual=5",
+	}
+	if got := connectorConfigDrift(desired, actual); !reflect.DeepEqual(got, want) {
+		t.Errorf("drift = %v, want %v", got, want)
+	}
+}
+
+func TestConnectorConfigDriftNone(t *testing.T) {
+	config := map[string]string{"tasks.max": "2"}
+	actual := map[string]string{"name": "orders-sink", "tasks.max": "2"}
+
+	if got := connectorConfigDrift(config, actual); len(got) != 0 {
+		t.Errorf("drift = %v, want none", got)
+	}
+}
+
+func TestAppliedConfigOutlivesRefresh(t *testing.T) {
+	ctx := context.Background()
+	private := fakePrivateState{}
+
+	if diags := setAppliedConfig(ctx, private, map[string]string{"tasks.max": "2"}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// After a refresh state holds the running config, drift is still measured against the applied one
+	running := map[string]string{"tasks.max": "4"}
+	for refresh := 1; refresh <= 2; refresh++ {
+		desired, diags := appliedConfig(ctx, private, testConnectorConfig(running))
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		want := map[string]string{"tasks.max": "desired=2, actual=4"}
+		if got := connectorConfigDrift(desired, running); !reflect.DeepEqual(got, want) {
+			t.Errorf("refresh %d: drift = %v, want %v", refresh, got, want)
+		}
+	}
+}
+
+func TestAppliedConfigFallsBackToState(t *testing.T) {
+	desired, diags := appliedConfig(context.Background(), fakePrivateState{}, testConnectorConfig(map[string]string{"tasks.max": "2"}))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if want := map[string]string{"tasks.max": "2"}; !reflect.DeepEqual(desired, want) {
+		t.Errorf("applied config = %v, want %v", desired, want)
+	}
+}
+
+func TestConfigDriftPlanModifier(t *testing.T) {
+	drift := map[string]string{"tasks.max": "desired=2, actual=4"}
+
+	tests := []struct {
+		name       string
+		planConfig map[string]string
+		want       types.Map
+	}{
+		{"config unchanged keeps drift", map[string]string{"tasks.max": "4"}, testConnectorConfig(drift)},
+		{"config changed clears drift", map[string]string{"tasks.max": "2"}, testConnectorConfig(map[string]string{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := resourceSchema(t, NewKafkaConnectConnectorResource())
+
+			prior := testConnectorData(map[string]string{"tasks.max": "4"}, drift)
+			planned := testConnectorData(tt.planConfig, nil)
+			planned.ConfigDrift = types.MapUnknown(types.StringType)
+
+			req := planmodifier.MapRequest{
+				Path:        path.Root("config_drift"),
+				State:       newTestState(t, s, &prior),
+				Plan:        newTestPlan(t, s, &planned),
+				StateValue:  prior.ConfigDrift,
+				PlanValue:   planned.ConfigDrift,
+				ConfigValue: types.MapNull(types.StringType),
+			}
+			resp := planmodifier.MapResponse{PlanValue: req.PlanValue}
+			configDriftPlanModifier{}.PlanModifyMap(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.want) {
+				t.Errorf("planned config_drift = %s, want %s", resp.PlanValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestConnectorApplyUpdateRestart(t *testing.T) {
+	tests := []struct {
+		name        string
+		restart     bool
+		planConfig  map[string]string
+		planPaused  bool
+		wantChanges []string
+	}{
+		{
+			name:       "config changed",
+			restart:    true,
+			planConfig: map[string]string{"tasks.max": "4"},
+			wantChanges: []string{
+				"PUT /api/v1/test-org/kafka/prod/connect/connect/orders-sink/config",
+				"POST /api/v1/test-org/kafka/prod/connect/connect/orders-sink/restart",
+			},
+		},
+		{
+			name:        "config changed without restart_on_config_update",
+			restart:     false,
+			planConfig:  map[string]string{"tasks.max": "4"},
+			wantChanges: []string{"PUT /api/v1/test-org/kafka/prod/connect/connect/orders-sink/config"},
+		},
+		{
+			name:       "only paused changed",
+			restart:    true,
+			planConfig: map[string]string{"tasks.max": "2"},
+			planPaused: true,
+			wantChanges: []string{
+				"PUT /api/v1/test-org/kafka/prod/connect/connect/orders-sink/config",
+				"PUT /api/v1/test-org/kafka/prod/connect/connect/orders-sink/pause",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var changes []string
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				changes = append(changes, r.Method+" "+r.URL.Path)
+				json.NewEncoder(w).Encode(axonopsClient.KafkaConnectorResponse{Name: "orders-sink", Type: "sink"})
+			}))
+			r := &connectorResource{client: client}
+
+			prior := testConnectorData(map[string]string{"tasks.max": "2"}, nil)
+			prior.RestartOnConfigUpdate = types.BoolValue(tt.restart)
+			planned := testConnectorData(tt.planConfig, nil)
+			planned.RestartOnConfigUpdate = types.BoolValue(tt.restart)
+			planned.Paused = types.BoolValue(tt.planPaused)
+
+			_, diags := r.applyUpdate(context.Background(), planned, prior, tt.planConfig)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("requests = %v, want %v", changes, tt.wantChanges)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*consumerGroupConfigResource)(nil)
+var _ resource.ResourceWithImportState = (*consumerGroupConfigResource)(nil)
+
+type consumerGroupConfigResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaConsumerGroupConfigResource() resource.Resource {
+	return &consumerGroupConfigResource{}
+}
+
+func (r *consumerGroupConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *consumerGroupConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_consumer_group_config"
+}
+
+func (r *consumerGroupConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The configs belong to the group, so moving them recreates them
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the configs of a Kafka consumer group. Use a single resource per group. Destroying the resource resets the group's configs to the defaults.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the Kafka cluster.",
+				PlanModifiers: replaceString,
+			},
+			"group_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the consumer group.",
+				PlanModifiers: replaceString,
+			},
+			"config": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "The group configs keyed by name (e.g., consumer.session.timeout.ms, consumer.heartbeat.interval.ms). Removed configs are reset to the default.",
+			},
+		},
+	}
+}
+
+type consumerGroupConfigResourceData struct {
+	ClusterName types.String            `tfsdk:"cluster_name"`
+	GroupId     types.String            `tfsdk:"group_id"`
+	Config      map[string]types.String `tfsdk:"config"`
+}
+
+func (r *consumerGroupConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data consumerGroupConfigResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes := configMapChanges(nil, data.Config)
+	if len(changes) > 0 {
+		err := r.client.UpdateConsumerGroupConfig(ctx, data.ClusterName.ValueString(), data.GroupId.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set consumer group configs: %s", err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Created kafka consumer group config resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *consumerGroupConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data consumerGroupConfigResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetConsumerGroupConfig(ctx, data.ClusterName.ValueString(), data.GroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read consumer group configs: %s", err))
+		return
+	}
+
+	// A config changed or removed outside of Terraform shows up in the next plan
+	config := make(map[string]types.String)
+	for key := range data.Config {
+		if value, ok := current[key]; ok {
+			config[key] = types.StringValue(value)
+		}
+	}
+	data.Config = config
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *consumerGroupConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planData consumerGroupConfigResourceData
+	var stateData consumerGroupConfigResourceData
+
+	diags := req.Plan.Get(ctx, &planData)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &stateData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes := configMapChanges(stateData.Config, planData.Config)
+	if len(changes) > 0 {
+		err := r.client.UpdateConsumerGroupConfig(ctx, planData.ClusterName.ValueString(), planData.GroupId.ValueString(), changes)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update consumer group configs: %s", err))
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Updated kafka consumer group config resource")
+
+	diags = resp.State.Set(ctx, &planData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *consumerGroupConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data consumerGroupConfigResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteConsumerGroupConfig(ctx, data.ClusterName.ValueString(), data.GroupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset consumer group configs: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted (reset) kafka consumer group config resource")
+}
+
+// ImportState imports the configs of a consumer group.
+// Import ID format: cluster_name/group_id
+func (r *consumerGroupConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterName, groupId, _ := strings.Cut(req.ID, "/")
+	if clusterName == "" || groupId == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_name/group_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	current, err := r.client.GetConsumerGroupConfig(ctx, clusterName, groupId)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read consumer group configs: %s", err))
+		return
+	}
+
+	config := make(map[string]types.String)
+	for key, value := range current {
+		config[key] = types.StringValue(value)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config"), config)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported consumer group configs for %s", req.ID))
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*consumerGroupResetResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*consumerGroupResetResource)(nil)
+
+type consumerGroupResetResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaConsumerGroupResetResource() resource.Resource {
+	return &consumerGroupResetResource{}
+}
+
+func (r *consumerGroupResetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *consumerGroupResetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_consumer_group_reset"
+}
+
+func (r *consumerGroupResetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// A reset can't be changed once done, so every change performs a new one
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Description: "Resets the committed offsets of a consumer group on a topic when created. Change triggers to reset again. The group must have no active members. Destroying the resource does not change the offsets.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the Kafka cluster.",
+				PlanModifiers: replaceString,
+			},
+			"group_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The consumer group ID.",
+				PlanModifiers: replaceString,
+			},
+			"topic": schema.StringAttribute{
+				Required:      true,
+				Description:   "The topic to reset the offsets on.",
+				PlanModifiers: replaceString,
+			},
+			"reset_type": schema.StringAttribute{
+				Required:      true,
+				Description:   "Where to move the offsets: to-earliest, to-latest, to-datetime, to-offset.",
+				PlanModifiers: replaceString,
+				Validators: []validator.String{
+					stringvalidator.OneOf("to-earliest", "to-latest", "to-datetime", "to-offset"),
+				},
+			},
+			"reset_value": schema.StringAttribute{
+				Optional:      true,
+				Description:   "An RFC 3339 timestamp for to-datetime (e.g., 2024-01-31T12:00:00Z), or the offset for to-offset.",
+				PlanModifiers: replaceString,
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary values that reset the offsets again when any of them changes, e.g. { incident = \"INC-1234\" }.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"offsets": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Description: "The current committed offset of the group per partition of the topic.",
+			},
+			"lag": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The current total lag of the group on the topic.",
+			},
+		},
+	}
+}
+
+type consumerGroupResetResourceData struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	GroupId     types.String `tfsdk:"group_id"`
+	Topic       types.String `tfsdk:"topic"`
+	ResetType   types.String `tfsdk:"reset_type"`
+	ResetValue  types.String `tfsdk:"reset_value"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	Offsets     types.Map    `tfsdk:"offsets"`
+	Lag         types.Int64  `tfsdk:"lag"`
+}
+
+func (r *consumerGroupResetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data consumerGroupResetResourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.ResetType.IsUnknown() || data.ResetValue.IsUnknown() {
+		return
+	}
+
+	resetValue := data.ResetValue.ValueString()
+	switch data.ResetType.ValueString() {
+	case "to-datetime":
+		if _, err := time.Parse(time.RFC3339, resetValue); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reset_value"),
+				"Invalid Reset Value",
+				fmt.Sprintf("to-datetime requires reset_value to be an RFC 3339 timestamp, got: %q", resetValue),
+			)
+		}
+	case "to-offset":
+		if offset, err := strconv.ParseInt(resetValue, 10, 64); err != nil || offset < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reset_value"),
+				"Invalid Reset Value",
+				fmt.Sprintf("to-offset requires reset_value to be a non-negative integer, got: %q", resetValue),
+			)
+		}
+	default:
+		if !data.ResetValue.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reset_value"),
+				"Invalid Reset Value",
+				fmt.Sprintf("reset_value can't be set for %s.", data.ResetType.ValueString()),
+			)
+		}
+	}
+}
+
+// refreshOffsets reads the committed offsets and lag of the group on the topic
+func (r *consumerGroupResetResource) refreshOffsets(ctx context.Context, data *consumerGroupResetResourceData) error {
+	group, err := r.client.GetConsumerGroup(ctx, data.ClusterName.ValueString(), data.GroupId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	offsets := make(map[string]attr.Value)
+	var lag int64
+	if group != nil {
+		for _, o := range group.Offsets {
+			if o.Topic != data.Topic.ValueString() {
+				continue
+			}
+			offsets[strconv.Itoa(int(o.Partition))] = types.Int64Value(o.Offset)
+			lag += o.Lag
+		}
+	}
+
+	data.Offsets = types.MapValueMust(types.Int64Type, offsets)
+	data.Lag = types.Int64Value(lag)
+	return nil
+}
+
+func (r *consumerGroupResetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data consumerGroupResetResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ResetConsumerGroupOffset(ctx, data.ClusterName.ValueString(), data.GroupId.ValueString(), axonopsClient.ConsumerGroupOffsetReset{
+		Topic:     data.Topic.ValueString(),
+		ResetType: data.ResetType.ValueString(),
+		Value:     data.ResetValue.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset consumer group offsets: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reset offsets of consumer group %s on topic %s %s", data.GroupId.ValueString(), data.Topic.ValueString(), data.ResetType.ValueString()))
+
+	err = r.refreshOffsets(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Offsets were reset but could not be read back: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *consumerGroupResetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data consumerGroupResetResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource is kept even when the group is gone, since removing it would reset the offsets again
+	err := r.refreshOffsets(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read consumer group offsets: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *consumerGroupResetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data consumerGroupResetResourceData
+
+	// Every configurable attribute requires replacement, so only computed values can change here
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refreshOffsets(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read consumer group offsets: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *consumerGroupResetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A reset can't be undone, the group continues from wherever it is now
+	tflog.Info(ctx, "Removed consumer group reset resource from state, the offsets are unchanged")
+}
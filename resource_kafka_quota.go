@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*kafkaQuotaResource)(nil)
+var _ resource.ResourceWithImportState = (*kafkaQuotaResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*kafkaQuotaResource)(nil)
+
+type kafkaQuotaResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaQuotaResource() resource.Resource {
+	return &kafkaQuotaResource{}
+}
+
+func (r *kafkaQuotaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *kafkaQuotaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_quota"
+}
+
+func (r *kafkaQuotaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// A quota is identified by its entity, so moving it recreates it
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a Kafka client quota limiting the throughput of a user or client-id.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the Kafka cluster.",
+				PlanModifiers: replaceString,
+			},
+			"entity_type": schema.StringAttribute{
+				Required:      true,
+				Description:   "The entity the quota applies to: user, client-id or user+client-id.",
+				PlanModifiers: replaceString,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "client-id", "user+client-id"),
+				},
+			},
+			"entity_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the entity. Use <user>/<client-id> for the user+client-id entity type.",
+				PlanModifiers: replaceString,
+			},
+			"producer_byte_rate": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The maximum produce rate in bytes per second per broker.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"consumer_byte_rate": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The maximum fetch rate in bytes per second per broker.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"request_percentage": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The percentage of broker request handler and network thread time the entity may use.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+type kafkaQuotaResourceData struct {
+	ClusterName       types.String  `tfsdk:"cluster_name"`
+	EntityType        types.String  `tfsdk:"entity_type"`
+	EntityName        types.String  `tfsdk:"entity_name"`
+	ProducerByteRate  types.Float64 `tfsdk:"producer_byte_rate"`
+	ConsumerByteRate  types.Float64 `tfsdk:"consumer_byte_rate"`
+	RequestPercentage types.Float64 `tfsdk:"request_percentage"`
+}
+
+func (r *kafkaQuotaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data kafkaQuotaResourceData
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ProducerByteRate.IsNull() && data.ConsumerByteRate.IsNull() && data.RequestPercentage.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Quota Limit",
+			"At least one of producer_byte_rate, consumer_byte_rate or request_percentage must be set.",
+		)
+	}
+}
+
+// buildQuota converts the resource data to the API representation
+func (d *kafkaQuotaResourceData) buildQuota() axonopsClient.KafkaQuota {
+	return axonopsClient.KafkaQuota{
+		EntityType:        d.EntityType.ValueString(),
+		EntityName:        d.EntityName.ValueString(),
+		ProducerByteRate:  d.ProducerByteRate.ValueFloat64Pointer(),
+		ConsumerByteRate:  d.ConsumerByteRate.ValueFloat64Pointer(),
+		RequestPercentage: d.RequestPercentage.ValueFloat64Pointer(),
+	}
+}
+
+// flattenQuota sets the limits from the API quota
+func (d *kafkaQuotaResourceData) flattenQuota(quota *axonopsClient.KafkaQuota) {
+	d.ProducerByteRate = types.Float64PointerValue(quota.ProducerByteRate)
+	d.ConsumerByteRate = types.Float64PointerValue(quota.ConsumerByteRate)
+	d.RequestPercentage = types.Float64PointerValue(quota.RequestPercentage)
+}
+
+func (r *kafkaQuotaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data kafkaQuotaResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateQuota(ctx, data.ClusterName.ValueString(), data.buildQuota())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create quota: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Created kafka quota resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *kafkaQuotaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data kafkaQuotaResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quota, err := r.client.GetQuota(ctx, data.ClusterName.ValueString(), data.EntityType.ValueString(), data.EntityName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read quota: %s", err))
+		return
+	}
+
+	if quota == nil {
+		// Quota was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.flattenQuota(quota)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *kafkaQuotaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data kafkaQuotaResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateQuota(ctx, data.ClusterName.ValueString(), data.buildQuota())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update quota: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Updated kafka quota resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *kafkaQuotaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data kafkaQuotaResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteQuota(ctx, data.ClusterName.ValueString(), data.EntityType.ValueString(), data.EntityName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete quota: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted kafka quota resource")
+}
+
+// ImportState imports an existing quota.
+// Import ID format: cluster_name/entity_type/entity_name
+func (r *kafkaQuotaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The entity name of a user+client-id quota contains a slash itself
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_name/entity_type/entity_name, got: %s", req.ID),
+		)
+		return
+	}
+
+	clusterName := parts[0]
+	entityType := parts[1]
+	entityName := parts[2]
+
+	quota, err := r.client.GetQuota(ctx, clusterName, entityType, entityName)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read quota: %s", err))
+		return
+	}
+
+	if quota == nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("No quota found for %s %s in cluster %s", entityType, entityName, clusterName))
+		return
+	}
+
+	data := kafkaQuotaResourceData{
+		ClusterName: types.StringValue(clusterName),
+		EntityType:  types.StringValue(entityType),
+		EntityName:  types.StringValue(entityName),
+	}
+	data.flattenQuota(quota)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported quota for %s %s from cluster %s", entityType, entityName, clusterName))
+}
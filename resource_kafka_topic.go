@@ -3,19 +3,64 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	axonopsClient "terraform-provider-axonops/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = (*topicResource)(nil)
 var _ resource.ResourceWithImportState = (*topicResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*topicResource)(nil)
+var _ resource.ResourceWithUpgradeState = (*topicResource)(nil)
+
+// topicDedicatedConfigKeys are the config map keys managed by dedicated attributes
+var topicDedicatedConfigKeys = []string{
+	"min_cleanable_dirty_ratio",
+	"delete_retention_ms",
+	"min_insync_replicas",
+	"retention_ms",
+	"cleanup_policy",
+}
+
+// topicConfigKeys is the reverse of TopicConfigKeyMap
+var topicConfigKeys = func() map[string]string {
+	keys := make(map[string]string, len(axonopsClient.TopicConfigKeyMap))
+	for key, name := range axonopsClient.TopicConfigKeyMap {
+		keys[name] = key
+	}
+	return keys
+}()
+
+// kafkaTopicConfigName returns the Kafka name of a config map key. Keys missing from
+// TopicConfigKeyMap are rejected by ValidateConfig and can only come from older state.
+func kafkaTopicConfigName(key string) string {
+	if name, ok := axonopsClient.TopicConfigKeyMap[key]; ok {
+		return name
+	}
+	return strings.ReplaceAll(key, "_", ".")
+}
+
+// topicConfigKey returns the config map key of a Kafka topic config name
+func topicConfigKey(name string) string {
+	if key, ok := topicConfigKeys[name]; ok {
+		return key
+	}
+	return strings.ReplaceAll(name, ".", "_")
+}
 
 type topicResource struct {
 	client *axonopsClient.AxonopsHttpClient
@@ -52,22 +97,68 @@ func (e *topicResource) Metadata(_ context.Context, req resource.MetadataRequest
 
 func (e *topicResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Required: true,
+				Required:    true,
+				Description: "The name of the topic. Changing it creates a new topic.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"partitions": schema.Int32Attribute{
-				Required: true,
+				Required:    true,
+				Description: "Number of partitions. Can be increased in place; Kafka doesn't support decreasing it.",
 			},
 			"replication_factor": schema.Int32Attribute{
 				Required: true,
 			},
 			"cluster_name": schema.StringAttribute{
-				Required: true,
+				Required:    true,
+				Description: "The name of the Kafka cluster. Changing it creates the topic on the new cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"config": schema.MapAttribute{
 				Optional:    true,
 				ElementType: types.StringType,
+				Description: "Other topic configs, keyed by the Kafka config name with underscores instead of dots (e.g., segment_bytes for segment.bytes). Unknown config names are rejected.",
+			},
+			"min_cleanable_dirty_ratio": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Minimum ratio of dirty log to total log before the log compactor will clean the log (min.cleanable.dirty.ratio). Requires a compact cleanup_policy.",
+				Validators: []validator.Float64{
+					float64validator.Between(0.0, 1.0),
+				},
+			},
+			"delete_retention_ms": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long delete tombstone markers are retained for compacted topics, in milliseconds (delete.retention.ms).",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_insync_replicas": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum number of replicas that must acknowledge a write when acks=all (min.insync.replicas).",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retention_ms": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long log segments are retained before being deleted, in milliseconds (retention.ms). -1 means no time limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(-1),
+				},
+			},
+			"cleanup_policy": schema.StringAttribute{
+				Optional:    true,
+				Description: "The retention policy for log segments (cleanup.policy). Valid values: delete, compact, compact,delete.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("delete", "compact", "compact,delete", "delete,compact"),
+				},
 			},
 		},
 	}
@@ -80,6 +171,203 @@ type topicResourceData struct {
 	ReplicationFactor types.Int32             `tfsdk:"replication_factor"`
 	ClusterName       types.String            `tfsdk:"cluster_name"`
 	Config            map[string]types.String `tfsdk:"config"`
+
+	MinCleanableDirtyRatio types.Float64 `tfsdk:"min_cleanable_dirty_ratio"`
+	DeleteRetentionMs      types.Int64   `tfsdk:"delete_retention_ms"`
+	MinInsyncReplicas      types.Int64   `tfsdk:"min_insync_replicas"`
+	RetentionMs            types.Int64   `tfsdk:"retention_ms"`
+	CleanupPolicy          types.String  `tfsdk:"cleanup_policy"`
+}
+
+// topicAttributeConfigs returns the Kafka configs set through dedicated attributes
+func (d *topicResourceData) topicAttributeConfigs() map[string]string {
+	configs := make(map[string]string)
+
+	if !d.MinCleanableDirtyRatio.IsNull() && !d.MinCleanableDirtyRatio.IsUnknown() {
+		configs["min.cleanable.dirty.ratio"] = strconv.FormatFloat(d.MinCleanableDirtyRatio.ValueFloat64(), 'f', -1, 64)
+	}
+	if !d.DeleteRetentionMs.IsNull() && !d.DeleteRetentionMs.IsUnknown() {
+		configs["delete.retention.ms"] = strconv.FormatInt(d.DeleteRetentionMs.ValueInt64(), 10)
+	}
+	if !d.MinInsyncReplicas.IsNull() && !d.MinInsyncReplicas.IsUnknown() {
+		configs["min.insync.replicas"] = strconv.FormatInt(d.MinInsyncReplicas.ValueInt64(), 10)
+	}
+	if !d.RetentionMs.IsNull() && !d.RetentionMs.IsUnknown() {
+		configs["retention.ms"] = strconv.FormatInt(d.RetentionMs.ValueInt64(), 10)
+	}
+	if !d.CleanupPolicy.IsNull() && !d.CleanupPolicy.IsUnknown() {
+		configs["cleanup.policy"] = d.CleanupPolicy.ValueString()
+	}
+
+	return configs
+}
+
+// setTopicAttributeConfig fills a dedicated attribute from a Kafka config entry.
+// It returns false if the config is not managed by a dedicated attribute.
+func (d *topicResourceData) setTopicAttributeConfig(name, value string) bool {
+	switch name {
+	case "min.cleanable.dirty.ratio":
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			d.MinCleanableDirtyRatio = types.Float64Value(v)
+		}
+		return true
+	case "delete.retention.ms":
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			d.DeleteRetentionMs = types.Int64Value(v)
+		}
+		return true
+	case "min.insync.replicas":
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			d.MinInsyncReplicas = types.Int64Value(v)
+		}
+		return true
+	case "retention.ms":
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			d.RetentionMs = types.Int64Value(v)
+		}
+		return true
+	case "cleanup.policy":
+		d.CleanupPolicy = types.StringValue(value)
+		return true
+	}
+	return false
+}
+
+// clearTopicAttributeConfigs resets every dedicated config attribute to null
+func (d *topicResourceData) clearTopicAttributeConfigs() {
+	d.MinCleanableDirtyRatio = types.Float64Null()
+	d.DeleteRetentionMs = types.Int64Null()
+	d.MinInsyncReplicas = types.Int64Null()
+	d.RetentionMs = types.Int64Null()
+	d.CleanupPolicy = types.StringNull()
+}
+
+func (e *topicResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config types.Map
+	var minCleanableDirtyRatio types.Float64
+	var cleanupPolicy types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("config"), &config)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("min_cleanable_dirty_ratio"), &minCleanableDirtyRatio)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cleanup_policy"), &cleanupPolicy)...)
+	if resp.Diagnostics.HasError() || config.IsUnknown() {
+		return
+	}
+
+	configElements := config.Elements()
+
+	// Dedicated attributes can't also be set through the config map
+	for _, key := range topicDedicatedConfigKeys {
+		if _, ok := configElements[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config").AtMapKey(key),
+				"Conflicting Topic Config",
+				fmt.Sprintf("%s has a dedicated attribute and can't be set in config. Move it from config to the %s attribute; existing state is migrated automatically.", axonopsClient.TopicConfigKeyMap[key], key),
+			)
+		}
+	}
+
+	for key := range configElements {
+		if _, ok := axonopsClient.TopicConfigKeyMap[key]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config").AtMapKey(key),
+				"Unknown Topic Config",
+				fmt.Sprintf("%s is not a known Kafka topic config. Use the config name with underscores instead of dots (e.g., segment_bytes for segment.bytes).", key),
+			)
+		}
+	}
+
+	// min.cleanable.dirty.ratio only applies to compacted topics
+	if minCleanableDirtyRatio.IsNull() || cleanupPolicy.IsUnknown() {
+		return
+	}
+
+	switch strings.ReplaceAll(cleanupPolicy.ValueString(), " ", "") {
+	case "compact", "compact,delete", "delete,compact":
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("min_cleanable_dirty_ratio"),
+		"Invalid Topic Config",
+		"min_cleanable_dirty_ratio requires cleanup_policy to be \"compact\" or \"compact,delete\".",
+	)
+}
+
+// kafkaClusterPolicy returns the policy of a cluster, or nil if it has none. A policy that
+// can't be read is treated as no policy, so clusters without one aren't blocked by the
+// policy endpoint failing.
+func kafkaClusterPolicy(ctx context.Context, client *axonopsClient.AxonopsHttpClient, clusterName string, diags *diag.Diagnostics) *axonopsClient.KafkaClusterPolicy {
+	policy, err := client.GetKafkaClusterPolicy(ctx, clusterName)
+	if err != nil {
+		diags.AddWarning("Cluster Policy Not Checked", fmt.Sprintf("Unable to read the policy of cluster %s, so it isn't enforced: %s", clusterName, err))
+		return nil
+	}
+	return policy
+}
+
+// clusterPolicyViolations returns the ways a new topic breaks the cluster policy
+func clusterPolicyViolations(policy *axonopsClient.KafkaClusterPolicy, partitions, replicationFactor int32, configList []axonopsClient.KafkaTopicConfig) []string {
+	var violations []string
+
+	if policy.MaxPartitionCount > 0 && int(partitions) > policy.MaxPartitionCount {
+		violations = append(violations, fmt.Sprintf("partitions %d exceeds the maximum of %d", partitions, policy.MaxPartitionCount))
+	}
+	if policy.MinReplicationFactor > 0 && int(replicationFactor) < policy.MinReplicationFactor {
+		violations = append(violations, fmt.Sprintf("replication_factor %d is below the minimum of %d", replicationFactor, policy.MinReplicationFactor))
+	}
+
+	configured := make(map[string]bool)
+	for _, c := range configList {
+		configured[c.Name] = true
+	}
+	for _, key := range policy.RequireConfigKeys {
+		if !configured[key] {
+			violations = append(violations, fmt.Sprintf("required config %s is not set", key))
+		}
+	}
+
+	return violations
+}
+
+// checkTopicCreatePolicy adds an error when creating a topic would break the cluster policy
+func checkTopicCreatePolicy(ctx context.Context, client *axonopsClient.AxonopsHttpClient, clusterName, topicName string, partitions, replicationFactor int32, configList []axonopsClient.KafkaTopicConfig, diags *diag.Diagnostics) {
+	policy := kafkaClusterPolicy(ctx, client, clusterName, diags)
+	if policy == nil {
+		return
+	}
+
+	violations := clusterPolicyViolations(policy, partitions, replicationFactor, configList)
+	if len(violations) > 0 {
+		diags.AddError(
+			"Cluster Policy Violation",
+			fmt.Sprintf("Topic %s violates the policy of cluster %s: %s", topicName, clusterName, strings.Join(violations, "; ")),
+		)
+	}
+}
+
+// checkTopicPartitionsPolicy adds an error when increasing the partitions of a topic would
+// exceed the cluster policy's maximum
+func checkTopicPartitionsPolicy(ctx context.Context, client *axonopsClient.AxonopsHttpClient, clusterName, topicName string, partitions int32, diags *diag.Diagnostics) {
+	policy := kafkaClusterPolicy(ctx, client, clusterName, diags)
+	if policy != nil && policy.MaxPartitionCount > 0 && int(partitions) > policy.MaxPartitionCount {
+		diags.AddAttributeError(
+			path.Root("partitions"),
+			"Cluster Policy Violation",
+			fmt.Sprintf("Topic %s violates the policy of cluster %s: partitions %d exceeds the maximum of %d", topicName, clusterName, partitions, policy.MaxPartitionCount),
+		)
+	}
+}
+
+// checkTopicDeletePolicy adds an error when the cluster policy prevents deleting topics
+func checkTopicDeletePolicy(ctx context.Context, client *axonopsClient.AxonopsHttpClient, clusterName, topicName string, diags *diag.Diagnostics) {
+	policy := kafkaClusterPolicy(ctx, client, clusterName, diags)
+	if policy != nil && policy.PreventTopicDeletion {
+		diags.AddError(
+			"Cluster Policy Violation",
+			fmt.Sprintf("Topic %s can't be deleted: the policy of cluster %s prevents topic deletion.", topicName, clusterName),
+		)
+	}
 }
 
 func (e *topicResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -92,13 +380,21 @@ func (e *topicResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// TF doesn't allow "." in configs so convert from _ to pass through to create function
+	// Config map keys use underscores, translate them to the Kafka config names
 	var configList []axonopsClient.KafkaTopicConfig
 	for key, value := range data.Config {
-		configList = append(configList, axonopsClient.KafkaTopicConfig{Name: strings.ReplaceAll(key, "_", "."), Value: value.ValueString()})
+		configList = append(configList, axonopsClient.KafkaTopicConfig{Name: kafkaTopicConfigName(key), Value: value.ValueString()})
+	}
+	for name, value := range data.topicAttributeConfigs() {
+		configList = append(configList, axonopsClient.KafkaTopicConfig{Name: name, Value: value})
 	}
 
-	err := e.client.CreateTopic(data.Name.ValueString(), data.ClusterName.ValueString(), data.Partitions.ValueInt32(), data.ReplicationFactor.ValueInt32(), configList)
+	checkTopicCreatePolicy(ctx, e.client, data.ClusterName.ValueString(), data.Name.ValueString(), data.Partitions.ValueInt32(), data.ReplicationFactor.ValueInt32(), configList, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := e.client.CreateTopic(ctx, data.Name.ValueString(), data.ClusterName.ValueString(), data.Partitions.ValueInt32(), data.ReplicationFactor.ValueInt32(), configList)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create topic, got error: %s", err))
 		return
@@ -117,7 +413,36 @@ func (e *topicResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Read resource using 3rd party API.
+	topic, err := e.client.GetTopic(ctx, data.Name.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read topic, got error: %s", err))
+		return
+	}
+
+	if topic == nil {
+		// Topic was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Partitions = types.Int32Value(topic.Partitions)
+	data.ReplicationFactor = types.Int32Value(topic.ReplicationFactor)
+
+	// Configs with dedicated attributes are set on those attributes,
+	// the rest go to the config map under their Terraform key
+	data.clearTopicAttributeConfigs()
+	config := make(map[string]types.String)
+	for _, c := range topic.Config {
+		if data.setTopicAttributeConfig(c.Name, c.Value) {
+			continue
+		}
+		config[topicConfigKey(c.Name)] = types.StringValue(c.Value)
+	}
+
+	// Keep an unset config map null rather than empty
+	if len(config) > 0 || data.Config != nil {
+		data.Config = config
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -141,8 +466,12 @@ func (e *topicResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	if planData.Partitions != stateData.Partitions {
-		resp.Diagnostics.AddError("Module Error", fmt.Sprintf("Changing of Partitions not supported yet"))
+	if planData.Partitions.ValueInt32() < stateData.Partitions.ValueInt32() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("partitions"),
+			"Cannot Decrease Partitions",
+			fmt.Sprintf("Kafka doesn't support reducing the partition count of a topic (%d to %d). Recreate the topic to reduce its partitions.", stateData.Partitions.ValueInt32(), planData.Partitions.ValueInt32()),
+		)
 		return
 	}
 
@@ -153,10 +482,40 @@ func (e *topicResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	var configList []axonopsClient.KafkaUpdateTopicConfig
 	for key, value := range planData.Config {
-		configList = append(configList, axonopsClient.KafkaUpdateTopicConfig{Key: strings.ReplaceAll(key, "_", "."), Value: value.ValueString(), Op: "SET"})
+		configList = append(configList, axonopsClient.KafkaUpdateTopicConfig{Key: kafkaTopicConfigName(key), Value: value.ValueString(), Op: "SET"})
+	}
+	// Reset configs that were removed from the config map, otherwise Read would bring them back
+	for key := range stateData.Config {
+		if _, ok := planData.Config[key]; !ok {
+			configList = append(configList, axonopsClient.KafkaUpdateTopicConfig{Key: kafkaTopicConfigName(key), Op: "DELETE"})
+		}
+	}
+
+	planAttributeConfigs := planData.topicAttributeConfigs()
+	for name, value := range planAttributeConfigs {
+		configList = append(configList, axonopsClient.KafkaUpdateTopicConfig{Key: name, Value: value, Op: "SET"})
+	}
+	// Reset dedicated attributes that were removed from the configuration
+	for name := range stateData.topicAttributeConfigs() {
+		if _, ok := planAttributeConfigs[name]; !ok {
+			configList = append(configList, axonopsClient.KafkaUpdateTopicConfig{Key: name, Op: "DELETE"})
+		}
+	}
+
+	if planData.Partitions.ValueInt32() > stateData.Partitions.ValueInt32() {
+		checkTopicPartitionsPolicy(ctx, e.client, planData.ClusterName.ValueString(), planData.Name.ValueString(), planData.Partitions.ValueInt32(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := e.client.IncreaseTopicPartitions(ctx, planData.Name.ValueString(), planData.ClusterName.ValueString(), planData.Partitions.ValueInt32())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to increase topic partitions, got error: %s", err))
+			return
+		}
 	}
 
-	err := e.client.UpdateTopicConfig(planData.Name.ValueString(), planData.ClusterName.ValueString(), planData.Partitions.ValueInt32(), planData.ReplicationFactor.ValueInt32(), configList)
+	err := e.client.UpdateTopicConfig(ctx, planData.Name.ValueString(), planData.ClusterName.ValueString(), planData.Partitions.ValueInt32(), planData.ReplicationFactor.ValueInt32(), configList)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update topic, got error: %s", err))
 		return
@@ -172,7 +531,12 @@ func (e *topicResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	diags := req.State.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
-	err := e.client.DeleteTopic(data.Name.ValueString(), data.ClusterName.ValueString())
+	checkTopicDeletePolicy(ctx, e.client, data.ClusterName.ValueString(), data.Name.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := e.client.DeleteTopic(ctx, data.Name.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete topic, got error: %s", err))
 		return
@@ -202,7 +566,7 @@ func (e *topicResource) ImportState(ctx context.Context, req resource.ImportStat
 	topicName := parts[1]
 
 	// Get topic details from the API
-	topic, err := e.client.GetTopic(topicName, clusterName)
+	topic, err := e.client.GetTopic(ctx, topicName, clusterName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
@@ -211,19 +575,128 @@ func (e *topicResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
+	if topic == nil {
+		resp.Diagnostics.AddError(
+			"Import Error",
+			fmt.Sprintf("Topic %s not found in cluster %s", topicName, clusterName),
+		)
+		return
+	}
+
 	// Set the state
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), topic.Name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("partitions"), topic.Partitions)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("replication_factor"), topic.ReplicationFactor)...)
 
-	// Convert config (dots to underscores for Terraform)
+	// Convert config names to their Terraform keys
+	// Configs with dedicated attributes are set on those attributes instead
+	var attributes topicResourceData
 	config := make(map[string]string)
 	for _, c := range topic.Config {
-		key := strings.ReplaceAll(c.Name, ".", "_")
-		config[key] = c.Value
+		if attributes.setTopicAttributeConfig(c.Name, c.Value) {
+			continue
+		}
+		config[topicConfigKey(c.Name)] = c.Value
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("config"), config)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("min_cleanable_dirty_ratio"), attributes.MinCleanableDirtyRatio)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("delete_retention_ms"), attributes.DeleteRetentionMs)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("min_insync_replicas"), attributes.MinInsyncReplicas)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("retention_ms"), attributes.RetentionMs)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cleanup_policy"), attributes.CleanupPolicy)...)
 
 	tflog.Info(ctx, fmt.Sprintf("Imported topic %s from cluster %s", topicName, clusterName))
 }
+
+type topicResourceDataV0 struct {
+	Name              types.String            `tfsdk:"name"`
+	Partitions        types.Int32             `tfsdk:"partitions"`
+	ReplicationFactor types.Int32             `tfsdk:"replication_factor"`
+	ClusterName       types.String            `tfsdk:"cluster_name"`
+	Config            map[string]types.String `tfsdk:"config"`
+}
+
+// UpgradeState migrates topic state from earlier schema versions.
+// Version 0 kept min.insync.replicas, retention.ms, cleanup.policy,
+// min.cleanable.dirty.ratio and delete.retention.ms in the config map; version 1
+// moves them to dedicated attributes.
+func (e *topicResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name":               schema.StringAttribute{Required: true},
+					"partitions":         schema.Int32Attribute{Required: true},
+					"replication_factor": schema.Int32Attribute{Required: true},
+					"cluster_name":       schema.StringAttribute{Required: true},
+					"config":             schema.MapAttribute{Optional: true, ElementType: types.StringType},
+				},
+			},
+			StateUpgrader: upgradeTopicStateV0,
+		},
+	}
+}
+
+func upgradeTopicStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior topicResourceDataV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := topicResourceData{
+		Name:                   prior.Name,
+		Partitions:             prior.Partitions,
+		ReplicationFactor:      prior.ReplicationFactor,
+		ClusterName:            prior.ClusterName,
+		MinCleanableDirtyRatio: types.Float64Null(),
+		DeleteRetentionMs:      types.Int64Null(),
+		MinInsyncReplicas:      types.Int64Null(),
+		RetentionMs:            types.Int64Null(),
+		CleanupPolicy:          types.StringNull(),
+	}
+
+	// Move the promoted keys out of the config map. Values that don't parse
+	// are left in the map so no data is lost.
+	if prior.Config != nil {
+		data.Config = make(map[string]types.String)
+		for key, value := range prior.Config {
+			switch key {
+			case "min_insync_replicas":
+				if v, err := strconv.ParseInt(value.ValueString(), 10, 64); err == nil {
+					data.MinInsyncReplicas = types.Int64Value(v)
+					continue
+				}
+			case "retention_ms":
+				if v, err := strconv.ParseInt(value.ValueString(), 10, 64); err == nil {
+					data.RetentionMs = types.Int64Value(v)
+					continue
+				}
+			case "cleanup_policy":
+				data.CleanupPolicy = value
+				continue
+			case "min_cleanable_dirty_ratio":
+				if v, err := strconv.ParseFloat(value.ValueString(), 64); err == nil {
+					data.MinCleanableDirtyRatio = types.Float64Value(v)
+					continue
+				}
+			case "delete_retention_ms":
+				if v, err := strconv.ParseInt(value.ValueString(), 10, 64); err == nil {
+					data.DeleteRetentionMs = types.Int64Value(v)
+					continue
+				}
+			}
+			data.Config[key] = value
+		}
+		// A config map that only held promoted keys is no longer configured
+		if len(data.Config) == 0 && len(prior.Config) > 0 {
+			data.Config = nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Upgraded state of topic %s to version 1", data.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
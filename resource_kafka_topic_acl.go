@@ -0,0 +1,346 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*topicACLResource)(nil)
+
+type topicACLResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewKafkaTopicACLResource() resource.Resource {
+	return &topicACLResource{}
+}
+
+func (r *topicACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *topicACLResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_topic_acl"
+}
+
+func (r *topicACLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Description: "Creates a Kafka topic together with the standard ACLs for one producer and one consumer: WRITE and DESCRIBE on the topic for the producer, READ on the topic and on the consumer group for the consumer. The topic is checked against the axonops_kafka_cluster_policy of the cluster like axonops_kafka_topic. If any ACL can't be created, the topic and the ACLs created so far are removed again. ACLs deleted outside of Terraform are recreated on the next apply. Destroying the resource deletes the topic and its ACLs.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the Kafka cluster.",
+				PlanModifiers: replaceString,
+			},
+			"topic_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the topic. Changing it creates a new topic.",
+				PlanModifiers: replaceString,
+			},
+			"partitions": schema.Int32Attribute{
+				Required:    true,
+				Description: "Number of partitions. Can be increased in place; Kafka doesn't support decreasing it.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"replication_factor": schema.Int32Attribute{
+				Required:    true,
+				Description: "Replication factor of the topic. Changing it creates a new topic.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"producer_principal": schema.StringAttribute{
+				Required:    true,
+				Description: "The principal allowed to write to the topic (e.g., User:orders-producer).",
+			},
+			"consumer_principal": schema.StringAttribute{
+				Required:    true,
+				Description: "The principal allowed to read the topic (e.g., User:orders-consumer).",
+			},
+			"consumer_group": schema.StringAttribute{
+				Required:    true,
+				Description: "The consumer group the consumer reads the topic with.",
+			},
+		},
+	}
+}
+
+type topicACLResourceData struct {
+	ClusterName       types.String `tfsdk:"cluster_name"`
+	TopicName         types.String `tfsdk:"topic_name"`
+	Partitions        types.Int32  `tfsdk:"partitions"`
+	ReplicationFactor types.Int32  `tfsdk:"replication_factor"`
+	ProducerPrincipal types.String `tfsdk:"producer_principal"`
+	ConsumerPrincipal types.String `tfsdk:"consumer_principal"`
+	ConsumerGroup     types.String `tfsdk:"consumer_group"`
+}
+
+// acls returns the standard producer and consumer ACLs for the topic
+func (d topicACLResourceData) acls() []axonopsClient.KafkaACL {
+	acl := func(resourceType, resourceName, principal, operation string) axonopsClient.KafkaACL {
+		return axonopsClient.KafkaACL{
+			ResourceType:        resourceType,
+			ResourceName:        resourceName,
+			ResourcePatternType: "LITERAL",
+			Principal:           principal,
+			Host:                "*",
+			Operation:           operation,
+			PermissionType:      "ALLOW",
+		}
+	}
+
+	topic := d.TopicName.ValueString()
+	return []axonopsClient.KafkaACL{
+		acl("TOPIC", topic, d.ProducerPrincipal.ValueString(), "WRITE"),
+		acl("TOPIC", topic, d.ProducerPrincipal.ValueString(), "DESCRIBE"),
+		acl("TOPIC", topic, d.ConsumerPrincipal.ValueString(), "READ"),
+		acl("GROUP", d.ConsumerGroup.ValueString(), d.ConsumerPrincipal.ValueString(), "READ"),
+	}
+}
+
+// missingACLs returns the indexes into acls of the ACLs absent from an ACL listing
+func (d topicACLResourceData) missingACLs(aclResponse *axonopsClient.ACLResponse) []int {
+	var missing []int
+	for i, acl := range d.acls() {
+		if !aclExistsIn(aclResponse, acl) {
+			missing = append(missing, i)
+		}
+	}
+	return missing
+}
+
+// createACLs creates each ACL in turn, returning the ones created before any failure
+func (r *topicACLResource) createACLs(ctx context.Context, clusterName string, acls []axonopsClient.KafkaACL) ([]axonopsClient.KafkaACL, error) {
+	var created []axonopsClient.KafkaACL
+	for _, acl := range acls {
+		if err := r.client.CreateACL(ctx, clusterName, acl); err != nil {
+			return created, fmt.Errorf("%s %s on %s %s: %w", acl.PermissionType, acl.Operation, acl.ResourceType, acl.ResourceName, err)
+		}
+		created = append(created, acl)
+	}
+	return created, nil
+}
+
+// deleteACLs deletes each ACL in turn
+func (r *topicACLResource) deleteACLs(ctx context.Context, clusterName string, acls []axonopsClient.KafkaACL) error {
+	for _, acl := range acls {
+		if err := r.client.DeleteACL(ctx, clusterName, acl); err != nil {
+			return fmt.Errorf("%s %s on %s %s: %w", acl.PermissionType, acl.Operation, acl.ResourceType, acl.ResourceName, err)
+		}
+	}
+	return nil
+}
+
+func (r *topicACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data topicACLResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := data.ClusterName.ValueString()
+	topicName := data.TopicName.ValueString()
+
+	checkTopicCreatePolicy(ctx, r.client, clusterName, topicName, data.Partitions.ValueInt32(), data.ReplicationFactor.ValueInt32(), nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateTopic(ctx, topicName, clusterName, data.Partitions.ValueInt32(), data.ReplicationFactor.ValueInt32(), []axonopsClient.KafkaTopicConfig{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create topic, got error: %s", err))
+		return
+	}
+
+	created, err := r.createACLs(ctx, clusterName, data.acls())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ACL, got error: %s", err))
+
+		// Roll back so a failed apply leaves nothing behind
+		if err := r.deleteACLs(ctx, clusterName, created); err != nil {
+			resp.Diagnostics.AddWarning("Cleanup Error", fmt.Sprintf("Unable to delete the ACLs created for topic %s: %s", topicName, err))
+		}
+		if err := r.client.DeleteTopic(ctx, topicName, clusterName); err != nil {
+			resp.Diagnostics.AddWarning("Cleanup Error", fmt.Sprintf("Unable to delete topic %s: %s", topicName, err))
+		}
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created topic %s with %d ACLs", topicName, len(created)))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *topicACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data topicACLResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topic, err := r.client.GetTopic(ctx, data.TopicName.ValueString(), data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read topic, got error: %s", err))
+		return
+	}
+
+	if topic == nil {
+		// Topic was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Partitions = types.Int32Value(topic.Partitions)
+	data.ReplicationFactor = types.Int32Value(topic.ReplicationFactor)
+
+	aclResponse, err := r.client.GetACLs(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACLs, got error: %s", err))
+		return
+	}
+
+	// Clear the attribute behind each ACL deleted outside of Terraform, so
+	// the next plan sets it again and Update recreates the ACL
+	missing := data.missingACLs(aclResponse)
+	for _, i := range missing {
+		switch i {
+		case 0, 1:
+			data.ProducerPrincipal = types.StringNull()
+		case 2:
+			data.ConsumerPrincipal = types.StringNull()
+		case 3:
+			data.ConsumerGroup = types.StringNull()
+		}
+	}
+	if len(missing) > 0 {
+		tflog.Warn(ctx, fmt.Sprintf("%d ACLs of topic %s were deleted outside of Terraform", len(missing), data.TopicName.ValueString()))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *topicACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var planData, stateData topicACLResourceData
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := planData.ClusterName.ValueString()
+	topicName := planData.TopicName.ValueString()
+
+	if planData.Partitions.ValueInt32() < stateData.Partitions.ValueInt32() {
+		resp.Diagnostics.AddError("Invalid Partitions", fmt.Sprintf("Kafka doesn't support decreasing the partitions of topic %s from %d to %d.", topicName, stateData.Partitions.ValueInt32(), planData.Partitions.ValueInt32()))
+		return
+	}
+	if planData.Partitions.ValueInt32() > stateData.Partitions.ValueInt32() {
+		checkTopicPartitionsPolicy(ctx, r.client, clusterName, topicName, planData.Partitions.ValueInt32(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.client.IncreaseTopicPartitions(ctx, topicName, clusterName, planData.Partitions.ValueInt32())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to increase topic partitions, got error: %s", err))
+			return
+		}
+	}
+
+	// Replace only the ACLs whose principal or group changed
+	oldACLs, newACLs := stateData.acls(), planData.acls()
+	var removed, added []axonopsClient.KafkaACL
+	for i := range newACLs {
+		if oldACLs[i] != newACLs[i] {
+			// An empty principal or group means Read found the ACL missing
+			if oldACLs[i].Principal != "" && oldACLs[i].ResourceName != "" {
+				removed = append(removed, oldACLs[i])
+			}
+			added = append(added, newACLs[i])
+		}
+	}
+
+	if _, err := r.createACLs(ctx, clusterName, added); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ACL, got error: %s", err))
+		return
+	}
+	if err := r.deleteACLs(ctx, clusterName, removed); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ACL, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated topic %s, replaced %d ACLs", topicName, len(added)))
+
+	diags := resp.State.Set(ctx, &planData)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *topicACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data topicACLResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := data.ClusterName.ValueString()
+
+	// Check before removing the ACLs, so a protected topic keeps working
+	checkTopicDeletePolicy(ctx, r.client, clusterName, data.TopicName.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.deleteACLs(ctx, clusterName, data.acls()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ACL, got error: %s", err))
+		return
+	}
+
+	err := r.client.DeleteTopic(ctx, data.TopicName.ValueString(), clusterName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete topic, got error: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleted topic %s and its ACLs", data.TopicName.ValueString()))
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testTopicACLData() topicACLResourceData {
+	return topicACLResourceData{
+		ClusterName:       types.StringValue("prod"),
+		TopicName:         types.StringValue("orders"),
+		Partitions:        types.Int32Value(3),
+		ReplicationFactor: types.Int32Value(2),
+		ProducerPrincipal: types.StringValue("User:producer"),
+		ConsumerPrincipal: types.StringValue("User:consumer"),
+		ConsumerGroup:     types.StringValue("orders-group"),
+	}
+}
+
+// aclListing groups ACLs by resource like the AxonOps API does
+func aclListing(acls []axonopsClient.KafkaACL) axonopsClient.ACLResponse {
+	var listing axonopsClient.ACLResponse
+	for _, acl := range acls {
+		listing.ACLResources = append(listing.ACLResources, axonopsClient.ACLResource{
+			ResourceType:        acl.ResourceType,
+			ResourceName:        acl.ResourceName,
+			ResourcePatternType: acl.ResourcePatternType,
+			ACLs:                []axonopsClient.KafkaACL{{Principal: acl.Principal, Host: acl.Host, Operation: acl.Operation, PermissionType: acl.PermissionType}},
+		})
+	}
+	return listing
+}
+
+func readTopicACL(t *testing.T, acls []axonopsClient.KafkaACL) topicACLResourceData {
+	t.Helper()
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/test-org/kafka/prod/topics/orders":
+			json.NewEncoder(w).Encode(axonopsClient.TopicInfo{Name: "orders", Partitions: 3, ReplicationFactor: 2})
+		case "/api/v1/test-org/kafka/prod/topics/orders/configs":
+			json.NewEncoder(w).Encode(axonopsClient.TopicConfigResponse{})
+		case "/api/v1/test-org/kafka/prod/acls":
+			json.NewEncoder(w).Encode(aclListing(acls))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	r := &topicACLResource{client: client}
+	s := resourceSchema(t, r)
+	prior := testTopicACLData()
+
+	resp := resource.ReadResponse{State: newTestState(t, s, &prior)}
+	r.Read(context.Background(), resource.ReadRequest{State: newTestState(t, s, &prior)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var data topicACLResourceData
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+	return data
+}
+
+func TestTopicACLReadKeepsPresentACLs(t *testing.T) {
+	want := testTopicACLData()
+	if got := readTopicACL(t, want.acls()); !reflect.DeepEqual(got, want) {
+		t.Errorf("state = %+v, want %+v", got, want)
+	}
+}
+
+func TestTopicACLReadDetectsMissingACL(t *testing.T) {
+	acls := testTopicACLData().acls()
+
+	// The consumer group ACL was deleted outside of Terraform
+	got := readTopicACL(t, acls[:3])
+	if !got.ConsumerGroup.IsNull() {
+		t.Errorf("consumer_group = %s, want null", got.ConsumerGroup)
+	}
+	if got.ProducerPrincipal.ValueString() != "User:producer" || got.ConsumerPrincipal.ValueString() != "User:consumer" {
+		t.Errorf("principals = %s, %s, want them unchanged", got.ProducerPrincipal, got.ConsumerPrincipal)
+	}
+}
+
+func TestTopicACLUpdateRecreatesMissingACL(t *testing.T) {
+	var created, deleted []axonopsClient.KafkaACL
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/test-org/kafka/prod/acls" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var acl axonopsClient.KafkaACL
+		json.NewDecoder(r.Body).Decode(&acl)
+		switch r.Method {
+		case http.MethodPost:
+			created = append(created, acl)
+		case http.MethodDelete:
+			deleted = append(deleted, acl)
+		}
+	}))
+
+	r := &topicACLResource{client: client}
+	s := resourceSchema(t, r)
+	prior := testTopicACLData()
+	prior.ConsumerGroup = types.StringNull()
+	planned := testTopicACLData()
+
+	resp := resource.UpdateResponse{State: newTestState(t, s, &prior)}
+	r.Update(context.Background(), resource.UpdateRequest{Plan: newTestPlan(t, s, &planned), State: newTestState(t, s, &prior)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if want := planned.acls()[3:]; !reflect.DeepEqual(created, want) {
+		t.Errorf("created = %v, want %v", created, want)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("deleted = %v, want nothing", deleted)
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// upgradeTopicV0 runs the version 0 state upgrader on a topic with the given config map
+func upgradeTopicV0(t *testing.T, config map[string]types.String) topicResourceData {
+	t.Helper()
+	ctx := context.Background()
+
+	r := NewKafkaTopicResource().(*topicResource)
+	upgrader := r.UpgradeState(ctx)[0]
+
+	prior := tfsdk.State{Schema: *upgrader.PriorSchema}
+	diags := prior.Set(ctx, &topicResourceDataV0{
+		Name:              types.StringValue("orders"),
+		Partitions:        types.Int32Value(6),
+		ReplicationFactor: types.Int32Value(3),
+		ClusterName:       types.StringValue("prod"),
+		Config:            config,
+	})
+	if diags.HasError() {
+		t.Fatalf("unable to build prior state: %v", diags)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected upgrade error: %v", resp.Diagnostics)
+	}
+
+	var data topicResourceData
+	if diags := resp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unable to read upgraded state: %v", diags)
+	}
+	return data
+}
+
+func TestUpgradeTopicStateV0MovesPromotedKeys(t *testing.T) {
+	data := upgradeTopicV0(t, map[string]types.String{
+		"min_insync_replicas":       types.StringValue("2"),
+		"retention_ms":              types.StringValue("604800000"),
+		"cleanup_policy":            types.StringValue("compact"),
+		"min_cleanable_dirty_ratio": types.StringValue("0.5"),
+		"delete_retention_ms":       types.StringValue("86400000"),
+		"segment_bytes":             types.StringValue("1073741824"),
+	})
+
+	if !data.MinInsyncReplicas.Equal(types.Int64Value(2)) {
+		t.Errorf("min_insync_replicas = %s, want 2", data.MinInsyncReplicas)
+	}
+	if !data.RetentionMs.Equal(types.Int64Value(604800000)) {
+		t.Errorf("retention_ms = %s, want 604800000", data.RetentionMs)
+	}
+	if !data.CleanupPolicy.Equal(types.StringValue("compact")) {
+		t.Errorf("cleanup_policy = %s, want compact", data.CleanupPolicy)
+	}
+	if !data.MinCleanableDirtyRatio.Equal(types.Float64Value(0.5)) {
+		t.Errorf("min_cleanable_dirty_ratio = %s, want 0.5", data.MinCleanableDirtyRatio)
+	}
+	if !data.DeleteRetentionMs.Equal(types.Int64Value(86400000)) {
+		t.Errorf("delete_retention_ms = %s, want 86400000", data.DeleteRetentionMs)
+	}
+
+	if len(data.Config) != 1 {
+		t.Fatalf("config = %v, want only segment_bytes", data.Config)
+	}
+	if !data.Config["segment_bytes"].Equal(types.StringValue("1073741824")) {
+		t.Errorf("config[segment_bytes] = %s, want 1073741824", data.Config["segment_bytes"])
+	}
+}
+
+func TestUpgradeTopicStateV0KeepsUnparseableValues(t *testing.T) {
+	data := upgradeTopicV0(t, map[string]types.String{
+		"min_insync_replicas":       types.StringValue("two"),
+		"retention_ms":              types.StringValue("7d"),
+		"min_cleanable_dirty_ratio": types.StringValue("half"),
+		"delete_retention_ms":       types.StringValue("1.5"),
+	})
+
+	if !data.MinInsyncReplicas.IsNull() || !data.RetentionMs.IsNull() ||
+		!data.MinCleanableDirtyRatio.IsNull() || !data.DeleteRetentionMs.IsNull() {
+		t.Errorf("unparseable values were promoted: %+v", data)
+	}
+
+	want := map[string]string{
+		"min_insync_replicas":       "two",
+		"retention_ms":              "7d",
+		"min_cleanable_dirty_ratio": "half",
+		"delete_retention_ms":       "1.5",
+	}
+	if len(data.Config) != len(want) {
+		t.Fatalf("config = %v, want %v", data.Config, want)
+	}
+	for key, value := range want {
+		if !data.Config[key].Equal(types.StringValue(value)) {
+			t.Errorf("config[%s] = %s, want %s", key, data.Config[key], value)
+		}
+	}
+}
+
+func TestUpgradeTopicStateV0LeavesOtherAttributes(t *testing.T) {
+	data := upgradeTopicV0(t, map[string]types.String{
+		"retention_ms": types.StringValue("1000"),
+	})
+
+	if !data.Name.Equal(types.StringValue("orders")) ||
+		!data.Partitions.Equal(types.Int32Value(6)) ||
+		!data.ReplicationFactor.Equal(types.Int32Value(3)) ||
+		!data.ClusterName.Equal(types.StringValue("prod")) {
+		t.Errorf("unexpected topic identity after upgrade: %+v", data)
+	}
+	if !data.MinInsyncReplicas.IsNull() || !data.CleanupPolicy.IsNull() ||
+		!data.MinCleanableDirtyRatio.IsNull() || !data.DeleteRetentionMs.IsNull() {
+		t.Errorf("keys missing from config were set: %+v", data)
+	}
+	// A config map that only held promoted keys is dropped
+	if data.Config != nil {
+		t.Errorf("config = %v, want null", data.Config)
+	}
+}
+
+func TestUpgradeTopicStateV0WithoutConfig(t *testing.T) {
+	data := upgradeTopicV0(t, nil)
+
+	if data.Config != nil {
+		t.Errorf("config = %v, want null", data.Config)
+	}
+	if !data.RetentionMs.IsNull() || !data.CleanupPolicy.IsNull() {
+		t.Errorf("promoted attributes set without config: %+v", data)
+	}
+}
@@ -4,23 +4,39 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	axonopsClient "terraform-provider-axonops/client"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = (*logCollectorResource)(nil)
 var _ resource.ResourceWithImportState = (*logCollectorResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*logCollectorResource)(nil)
+
+// archiveAttrTypes describes the archive attribute
+var archiveAttrTypes = map[string]attr.Type{
+	"enabled":       types.BoolType,
+	"remote_type":   types.StringType,
+	"remote_path":   types.StringType,
+	"remote_config": types.StringType,
+	"archive_after": types.StringType,
+}
 
 type logCollectorResource struct {
 	client *axonopsClient.AxonopsHttpClient
@@ -55,12 +71,13 @@ func (r *logCollectorResource) Metadata(_ context.Context, req resource.Metadata
 
 func (r *logCollectorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a log collector configuration for a Kafka cluster.",
+		Description: "Manages a log collector configuration for a Kafka, Cassandra or DSE cluster.",
 		Attributes: map[string]schema.Attribute{
 			"cluster_name": schema.StringAttribute{
 				Required:    true,
-				Description: "The name of the Kafka cluster.",
+				Description: "The name of the cluster.",
 			},
+			"cluster_type": clusterTypeAttribute(),
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "The name of the log collector.",
@@ -116,12 +133,45 @@ func (r *logCollectorResource) Schema(ctx context.Context, req resource.SchemaRe
 				Default:     int64default.StaticInt64(0),
 				Description: "Threshold for error alerts. Default: 0",
 			},
+			"archive": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Archives collected logs to remote storage for long-term retention.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Whether log archiving is enabled. Default: false",
+					},
+					"remote_type": schema.StringAttribute{
+						Optional:    true,
+						Description: "The remote storage type: s3, azure or gcs. Required when enabled.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("s3", "azure", "gcs"),
+						},
+					},
+					"remote_path": schema.StringAttribute{
+						Optional:    true,
+						Description: "The remote path to archive logs to (e.g., my-bucket/kafka-logs). Required when enabled.",
+					},
+					"remote_config": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Remote storage configuration, such as credentials.",
+					},
+					"archive_after": schema.StringAttribute{
+						Optional:    true,
+						Description: "How long logs are kept locally before being archived (e.g., 24h).",
+					},
+				},
+			},
 		},
 	}
 }
 
 type logCollectorResourceData struct {
 	ClusterName         types.String `tfsdk:"cluster_name"`
+	ClusterType         types.String `tfsdk:"cluster_type"`
 	Name                types.String `tfsdk:"name"`
 	UUID                types.String `tfsdk:"uuid"`
 	Filename            types.String `tfsdk:"filename"`
@@ -132,6 +182,105 @@ type logCollectorResourceData struct {
 	DebugRegex          types.String `tfsdk:"debug_regex"`
 	SupportedAgentTypes types.List   `tfsdk:"supported_agent_types"`
 	ErrorAlertThreshold types.Int64  `tfsdk:"error_alert_threshold"`
+	Archive             types.Object `tfsdk:"archive"`
+}
+
+type archiveData struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	RemoteType   types.String `tfsdk:"remote_type"`
+	RemotePath   types.String `tfsdk:"remote_path"`
+	RemoteConfig types.String `tfsdk:"remote_config"`
+	ArchiveAfter types.String `tfsdk:"archive_after"`
+}
+
+func (r *logCollectorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var archive types.Object
+
+	diags := req.Config.GetAttribute(ctx, path.Root("archive"), &archive)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || archive.IsNull() || archive.IsUnknown() {
+		return
+	}
+
+	var data archiveData
+	diags = archive.As(ctx, &data, basetypes.ObjectAsOptions{})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ArchiveAfter.IsNull() && !data.ArchiveAfter.IsUnknown() {
+		if _, err := time.ParseDuration(data.ArchiveAfter.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("archive").AtName("archive_after"), "Invalid Duration", fmt.Sprintf("archive_after must be a duration (e.g., 24h), got: %s", data.ArchiveAfter.ValueString()))
+		}
+	}
+
+	if !data.Enabled.ValueBool() {
+		return
+	}
+
+	if data.RemoteType.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("archive").AtName("remote_type"), "Missing Attribute", "remote_type is required when archiving is enabled.")
+	}
+	if data.RemotePath.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("archive").AtName("remote_path"), "Missing Attribute", "remote_path is required when archiving is enabled.")
+	}
+}
+
+// buildArchive converts the archive attribute to the API representation
+func buildArchive(ctx context.Context, obj types.Object) (*axonopsClient.ArchiveConfig, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+
+	var data archiveData
+	diags := obj.As(ctx, &data, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &axonopsClient.ArchiveConfig{
+		Enabled:      data.Enabled.ValueBool(),
+		RemoteType:   data.RemoteType.ValueString(),
+		RemotePath:   data.RemotePath.ValueString(),
+		RemoteConfig: data.RemoteConfig.ValueString(),
+		ArchiveAfter: data.ArchiveAfter.ValueString(),
+	}, diags
+}
+
+// flattenArchive converts the API archive configuration to the archive attribute.
+// remote_config is kept from prior state when the API doesn't return it.
+func flattenArchive(ctx context.Context, archive *axonopsClient.ArchiveConfig, prior types.Object) (types.Object, diag.Diagnostics) {
+	if archive == nil {
+		return types.ObjectNull(archiveAttrTypes), nil
+	}
+
+	remoteConfig := types.StringValue(archive.RemoteConfig)
+	if archive.RemoteConfig == "" {
+		remoteConfig = types.StringNull()
+		if !prior.IsNull() && !prior.IsUnknown() {
+			var priorData archiveData
+			if diags := prior.As(ctx, &priorData, basetypes.ObjectAsOptions{}); !diags.HasError() {
+				remoteConfig = priorData.RemoteConfig
+			}
+		}
+	}
+
+	return types.ObjectValueFrom(ctx, archiveAttrTypes, archiveData{
+		Enabled:      types.BoolValue(archive.Enabled),
+		RemoteType:   optionalString(archive.RemoteType),
+		RemotePath:   optionalString(archive.RemotePath),
+		RemoteConfig: remoteConfig,
+		ArchiveAfter: optionalString(archive.ArchiveAfter),
+	})
+}
+
+// optionalString maps an empty API string to null so unset optional attributes don't drift
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
 }
 
 func (r *logCollectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -145,7 +294,7 @@ func (r *logCollectorResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Get existing log collectors
-	existingCollectors, err := r.client.GetLogCollectors(data.ClusterName.ValueString())
+	existingCollectors, err := r.client.GetLogCollectors(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing log collectors, got error: %s", err))
 		return
@@ -162,6 +311,12 @@ func (r *logCollectorResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	archive, diags := buildArchive(ctx, data.Archive)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the new collector config
 	newCollector := axonopsClient.LogCollectorConfig{
 		Name:                data.Name.ValueString(),
@@ -174,13 +329,14 @@ func (r *logCollectorResource) Create(ctx context.Context, req resource.CreateRe
 		DebugRegex:          data.DebugRegex.ValueString(),
 		SupportedAgentType:  supportedAgentTypes,
 		ErrorAlertThreshold: int(data.ErrorAlertThreshold.ValueInt64()),
+		Archive:             archive,
 	}
 
 	// Add to existing collectors
 	allCollectors := append(existingCollectors, newCollector)
 
 	// Update all collectors
-	err = r.client.UpdateLogCollectors(data.ClusterName.ValueString(), allCollectors)
+	err = r.client.UpdateLogCollectors(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), allCollectors)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create log collector, got error: %s", err))
 		return
@@ -205,8 +361,10 @@ func (r *logCollectorResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	data.ClusterType = clusterTypeOrDefault(data.ClusterType)
+
 	// Get all log collectors
-	collectors, err := r.client.GetLogCollectors(data.ClusterName.ValueString())
+	collectors, err := r.client.GetLogCollectors(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read log collectors, got error: %s", err))
 		return
@@ -241,6 +399,9 @@ func (r *logCollectorResource) Read(ctx context.Context, req resource.ReadReques
 	data.SupportedAgentTypes, diags = types.ListValueFrom(ctx, types.StringType, found.SupportedAgentType)
 	resp.Diagnostics.Append(diags...)
 
+	data.Archive, diags = flattenArchive(ctx, found.Archive, data.Archive)
+	resp.Diagnostics.Append(diags...)
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -256,6 +417,8 @@ func (r *logCollectorResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	planData.ClusterType = clusterTypeOrDefault(planData.ClusterType)
+
 	diags = req.State.Get(ctx, &stateData)
 	resp.Diagnostics.Append(diags...)
 
@@ -264,7 +427,7 @@ func (r *logCollectorResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Get existing log collectors
-	existingCollectors, err := r.client.GetLogCollectors(planData.ClusterName.ValueString())
+	existingCollectors, err := r.client.GetLogCollectors(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing log collectors, got error: %s", err))
 		return
@@ -278,6 +441,12 @@ func (r *logCollectorResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	archive, diags := buildArchive(ctx, planData.Archive)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Find and update our collector by name (UUID may have changed)
 	found := false
 	for i, c := range existingCollectors {
@@ -293,6 +462,7 @@ func (r *logCollectorResource) Update(ctx context.Context, req resource.UpdateRe
 				DebugRegex:          planData.DebugRegex.ValueString(),
 				SupportedAgentType:  supportedAgentTypes,
 				ErrorAlertThreshold: int(planData.ErrorAlertThreshold.ValueInt64()),
+				Archive:             archive,
 			}
 			found = true
 			break
@@ -305,7 +475,7 @@ func (r *logCollectorResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	// Update all collectors
-	err = r.client.UpdateLogCollectors(planData.ClusterName.ValueString(), existingCollectors)
+	err = r.client.UpdateLogCollectors(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), existingCollectors)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update log collector, got error: %s", err))
 		return
@@ -330,8 +500,10 @@ func (r *logCollectorResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	data.ClusterType = clusterTypeOrDefault(data.ClusterType)
+
 	// Get existing log collectors
-	existingCollectors, err := r.client.GetLogCollectors(data.ClusterName.ValueString())
+	existingCollectors, err := r.client.GetLogCollectors(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get existing log collectors, got error: %s", err))
 		return
@@ -346,7 +518,7 @@ func (r *logCollectorResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Update all collectors (without our deleted one)
-	err = r.client.UpdateLogCollectors(data.ClusterName.ValueString(), updatedCollectors)
+	err = r.client.UpdateLogCollectors(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), updatedCollectors)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete log collector, got error: %s", err))
 		return
@@ -356,23 +528,28 @@ func (r *logCollectorResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 // ImportState imports an existing log collector into Terraform state.
-// Import ID format: cluster_name/log_collector_name
+// Import ID format: cluster_type/cluster_name/log_collector_name
 func (r *logCollectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Parse the import ID
 	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
+	if len(parts) == 2 {
+		// IDs without a cluster type predate Cassandra support
+		parts = append([]string{"kafka"}, parts...)
+	}
+	if len(parts) != 3 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: cluster_name/log_collector_name, got: %s", req.ID),
+			fmt.Sprintf("Expected import ID format: cluster_type/cluster_name/log_collector_name, got: %s", req.ID),
 		)
 		return
 	}
 
-	clusterName := parts[0]
-	collectorName := parts[1]
+	clusterType := parts[0]
+	clusterName := parts[1]
+	collectorName := parts[2]
 
 	// Get all log collectors
-	collectors, err := r.client.GetLogCollectors(clusterName)
+	collectors, err := r.client.GetLogCollectors(ctx, clusterType, clusterName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
@@ -400,6 +577,7 @@ func (r *logCollectorResource) ImportState(ctx context.Context, req resource.Imp
 
 	// Set the state
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_type"), clusterType)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), found.Name)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), found.UUID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("filename"), found.Filename)...)
@@ -411,5 +589,9 @@ func (r *logCollectorResource) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("error_alert_threshold"), int64(found.ErrorAlertThreshold))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("supported_agent_types"), found.SupportedAgentType)...)
 
+	archive, diags := flattenArchive(ctx, found.Archive, types.ObjectNull(archiveAttrTypes))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("archive"), archive)...)
+
 	tflog.Info(ctx, fmt.Sprintf("Imported log collector %s from cluster %s", collectorName, clusterName))
 }
@@ -3,23 +3,105 @@ package main
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	axonopsClient "terraform-provider-axonops/client"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = (*metricAlertRuleResource)(nil)
 var _ resource.ResourceWithImportState = (*metricAlertRuleResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*metricAlertRuleResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*metricAlertRuleResource)(nil)
+
+// metricNamespacePrefixes maps a metric namespace to the prefix its metric names start with.
+// Kafka metric names don't share a prefix, so the kafka namespace is accepted for any metric
+// and never derived.
+var metricNamespacePrefixes = map[string]string{
+	"cassandra": "cas_",
+	"system":    "host_",
+}
+
+var metricIdentifierRegex = regexp.MustCompile(`[a-zA-Z_:][a-zA-Z0-9_:]*`)
+
+// metricName returns the first metric name in a PromQL-style expression,
+// skipping function names such as sum( or rate(
+func metricName(expr string) string {
+	for _, loc := range metricIdentifierRegex.FindAllStringIndex(expr, -1) {
+		rest := strings.TrimLeft(expr[loc[1]:], " ")
+		if strings.HasPrefix(rest, "(") {
+			continue
+		}
+		return expr[loc[0]:loc[1]]
+	}
+	return ""
+}
+
+// metricNamespace returns the namespace of a metric expression, or null if it is not recognised
+func metricNamespace(expr string) types.String {
+	name := metricName(expr)
+	for namespace, prefix := range metricNamespacePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return types.StringValue(namespace)
+		}
+	}
+	return types.StringNull()
+}
+
+// namespacePlanModifier plans the namespace derived from the metric when it isn't configured.
+// The prior namespace is only kept while the metric is unchanged.
+type namespacePlanModifier struct{}
+
+func (m namespacePlanModifier) Description(_ context.Context) string {
+	return "Derives the namespace from the metric when it is not configured."
+}
+
+func (m namespacePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m namespacePlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if !req.ConfigValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var metric types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("metric"), &metric)...)
+	if resp.Diagnostics.HasError() || metric.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var priorMetric types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("metric"), &priorMetric)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if metric.Equal(priorMetric) {
+			resp.PlanValue = req.StateValue
+			return
+		}
+	}
+
+	resp.PlanValue = metricNamespace(metric.ValueString())
+}
 
 type metricAlertRuleResource struct {
 	client *axonopsClient.AxonopsHttpClient
@@ -73,24 +155,58 @@ func (r *metricAlertRuleResource) Schema(ctx context.Context, req resource.Schem
 				Description: "The name of the alert rule.",
 			},
 			"metric": schema.StringAttribute{
-				Required:    true,
-				Description: "The PromQL-style metric expression.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The PromQL-style metric expression. Required unless cloning from a source rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The metric namespace: kafka, cassandra, or system. When set to cassandra or system, the metric must start with cas_ or host_ respectively. Derived from the metric when not set, null if it matches neither prefix.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("kafka", "cassandra", "system"),
+				},
+				PlanModifiers: []planmodifier.String{
+					namespacePlanModifier{},
+				},
 			},
 			"operator": schema.StringAttribute{
-				Required:    true,
-				Description: "Comparison operator: >, >=, =, !=, <=, <",
+				Optional:    true,
+				Computed:    true,
+				Description: "Comparison operator: >, >=, =, !=, <=, <. Required unless cloning from a source rule.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(">", ">=", "=", "!=", "<=", "<"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"warning_value": schema.Float64Attribute{
-				Required:    true,
-				Description: "Warning threshold value.",
+				Optional:    true,
+				Computed:    true,
+				Description: "Warning threshold value. Required unless cloning from a source rule.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"critical_value": schema.Float64Attribute{
-				Required:    true,
-				Description: "Critical threshold value.",
+				Optional:    true,
+				Computed:    true,
+				Description: "Critical threshold value. Required unless cloning from a source rule.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"duration": schema.StringAttribute{
-				Required:    true,
-				Description: "Duration before triggering (e.g., 15m, 1h).",
+				Optional:    true,
+				Computed:    true,
+				Description: "Duration before triggering (e.g., 15m, 1h). Required unless cloning from a source rule.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"description": schema.StringAttribute{
 				Optional:    true,
@@ -98,6 +214,17 @@ func (r *metricAlertRuleResource) Schema(ctx context.Context, req resource.Schem
 				Default:     stringdefault.StaticString(""),
 				Description: "Description of the alert rule.",
 			},
+			"summary": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Summary of the alert shown in notifications. Default: generated from the name and operator.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the alert rule is evaluated. Disabled rules are kept but never fire. Default: true",
+			},
 			"dc": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -154,6 +281,36 @@ func (r *metricAlertRuleResource) Schema(ctx context.Context, req resource.Schem
 				Default:     emptyList,
 				Description: "Group by fields (e.g., dc, host_id, rack, scope).",
 			},
+			"source_cluster_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The cluster holding the rule to clone. Attributes not set in the configuration are copied from the source rule when the rule is created. Changing the source creates a new rule.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("source_cluster_type"), path.MatchRoot("source_rule_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_cluster_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The cluster type of the cluster holding the rule to clone.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("source_cluster_name"), path.MatchRoot("source_rule_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_rule_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the rule to clone.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("source_cluster_name"), path.MatchRoot("source_cluster_type")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -164,11 +321,14 @@ type metricAlertRuleResourceData struct {
 	ID            types.String  `tfsdk:"id"`
 	Name          types.String  `tfsdk:"name"`
 	Metric        types.String  `tfsdk:"metric"`
+	Namespace     types.String  `tfsdk:"namespace"`
 	Operator      types.String  `tfsdk:"operator"`
 	WarningValue  types.Float64 `tfsdk:"warning_value"`
 	CriticalValue types.Float64 `tfsdk:"critical_value"`
 	Duration      types.String  `tfsdk:"duration"`
 	Description   types.String  `tfsdk:"description"`
+	Summary       types.String  `tfsdk:"summary"`
+	Enabled       types.Bool    `tfsdk:"enabled"`
 	Dc            types.List    `tfsdk:"dc"`
 	Rack          types.List    `tfsdk:"rack"`
 	HostId        types.List    `tfsdk:"host_id"`
@@ -177,6 +337,80 @@ type metricAlertRuleResourceData struct {
 	Percentile    types.List    `tfsdk:"percentile"`
 	Consistency   types.List    `tfsdk:"consistency"`
 	GroupBy       types.List    `tfsdk:"group_by"`
+
+	SourceClusterName types.String `tfsdk:"source_cluster_name"`
+	SourceClusterType types.String `tfsdk:"source_cluster_type"`
+	SourceRuleID      types.String `tfsdk:"source_rule_id"`
+}
+
+func (r *metricAlertRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var namespace, metric, sourceRuleID types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("namespace"), &namespace)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metric"), &metric)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_rule_id"), &sourceRuleID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a source rule to clone, the rule definition must be configured
+	if sourceRuleID.IsNull() {
+		var operator, duration types.String
+		var warningValue, criticalValue types.Float64
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("operator"), &operator)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("duration"), &duration)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("warning_value"), &warningValue)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("critical_value"), &criticalValue)...)
+
+		required := map[string]bool{
+			"metric":         metric.IsNull(),
+			"operator":       operator.IsNull(),
+			"warning_value":  warningValue.IsNull(),
+			"critical_value": criticalValue.IsNull(),
+			"duration":       duration.IsNull(),
+		}
+		for name, missing := range required {
+			if missing {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Missing Attribute",
+					fmt.Sprintf("%s is required unless source_rule_id is set.", name),
+				)
+			}
+		}
+	}
+
+	if namespace.IsNull() || namespace.IsUnknown() || metric.IsNull() || metric.IsUnknown() {
+		return
+	}
+
+	prefix, ok := metricNamespacePrefixes[namespace.ValueString()]
+	if !ok {
+		return
+	}
+
+	if !strings.HasPrefix(metricName(metric.ValueString()), prefix) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("metric"),
+			"Metric Namespace Mismatch",
+			fmt.Sprintf("Metrics in the %s namespace must start with %q, got: %s", namespace.ValueString(), prefix, metric.ValueString()),
+		)
+	}
+}
+
+// setNamespace derives the namespace from the metric expression when it isn't configured
+func (d *metricAlertRuleResourceData) setNamespace() {
+	if d.Namespace.IsNull() || d.Namespace.IsUnknown() {
+		d.Namespace = metricNamespace(d.Metric.ValueString())
+	}
+}
+
+// setSummary generates the summary from the name and operator when it isn't configured
+func (d *metricAlertRuleResourceData) setSummary() {
+	if d.Summary.IsNull() || d.Summary.IsUnknown() {
+		d.Summary = types.StringValue(fmt.Sprintf("%s is %s than threshold (current value: {{$value}})", d.Name.ValueString(), d.Operator.ValueString()))
+	}
 }
 
 func (r *metricAlertRuleResource) buildFilters(ctx context.Context, data *metricAlertRuleResourceData) []axonopsClient.MetricAlertFilter {
@@ -208,8 +442,6 @@ func (r *metricAlertRuleResource) buildFilters(ctx context.Context, data *metric
 }
 
 func (r *metricAlertRuleResource) buildRule(data *metricAlertRuleResourceData, filters []axonopsClient.MetricAlertFilter) axonopsClient.MetricAlertRule {
-	summary := fmt.Sprintf("%s is %s than threshold (current value: {{$value}})", data.Name.ValueString(), data.Operator.ValueString())
-
 	return axonopsClient.MetricAlertRule{
 		ID:            data.ID.ValueString(),
 		Alert:         data.Name.ValueString(),
@@ -220,12 +452,162 @@ func (r *metricAlertRuleResource) buildRule(data *metricAlertRuleResourceData, f
 		Expr:          data.Metric.ValueString(),
 		Annotations: axonopsClient.MetricAlertAnnotations{
 			Description: data.Description.ValueString(),
-			Summary:     summary,
+			Summary:     data.Summary.ValueString(),
 		},
 		Filters: filters,
 	}
 }
 
+// filterFields returns the filter attributes keyed by their API filter name
+func (d *metricAlertRuleResourceData) filterFields() map[string]*types.List {
+	return map[string]*types.List{
+		"dc":          &d.Dc,
+		"rack":        &d.Rack,
+		"host_id":     &d.HostId,
+		"scope":       &d.Scope,
+		"keyspace":    &d.Keyspace,
+		"percentile":  &d.Percentile,
+		"consistency": &d.Consistency,
+		"groupBy":     &d.GroupBy,
+	}
+}
+
+// unsetUnconfigured marks the attributes that aren't set in config as unknown, so they
+// are copied from the source rule rather than taking their defaults
+func (d *metricAlertRuleResourceData) unsetUnconfigured(config *metricAlertRuleResourceData) {
+	if config.Metric.IsNull() {
+		d.Metric = types.StringUnknown()
+	}
+	if config.Operator.IsNull() {
+		d.Operator = types.StringUnknown()
+	}
+	if config.WarningValue.IsNull() {
+		d.WarningValue = types.Float64Unknown()
+	}
+	if config.CriticalValue.IsNull() {
+		d.CriticalValue = types.Float64Unknown()
+	}
+	if config.Duration.IsNull() {
+		d.Duration = types.StringUnknown()
+	}
+	if config.Description.IsNull() {
+		d.Description = types.StringUnknown()
+	}
+
+	configFilters := config.filterFields()
+	for name, target := range d.filterFields() {
+		if configFilters[name].IsNull() {
+			*target = types.ListUnknown(types.StringType)
+		}
+	}
+}
+
+// cloneFrom copies the attributes that are still unknown from a source rule
+func (d *metricAlertRuleResourceData) cloneFrom(ctx context.Context, source *axonopsClient.MetricAlertRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Metric.IsUnknown() {
+		d.Metric = types.StringValue(source.Expr)
+	}
+	if d.Operator.IsUnknown() {
+		d.Operator = types.StringValue(source.Operator)
+	}
+	if d.WarningValue.IsUnknown() {
+		d.WarningValue = types.Float64Value(source.WarningValue)
+	}
+	if d.CriticalValue.IsUnknown() {
+		d.CriticalValue = types.Float64Value(source.CriticalValue)
+	}
+	if d.Duration.IsUnknown() {
+		d.Duration = types.StringValue(source.For)
+	}
+	if d.Description.IsUnknown() {
+		d.Description = types.StringValue(source.Annotations.Description)
+	}
+
+	sourceFilters := make(map[string][]string)
+	for _, filter := range source.Filters {
+		sourceFilters[filter.Name] = filter.Value
+	}
+
+	for name, target := range d.filterFields() {
+		if !target.IsUnknown() {
+			continue
+		}
+		values := sourceFilters[name]
+		if values == nil {
+			values = []string{}
+		}
+		var listDiags diag.Diagnostics
+		*target, listDiags = types.ListValueFrom(ctx, types.StringType, values)
+		diags.Append(listDiags...)
+	}
+
+	return diags
+}
+
+// sourceRule looks up the rule to clone
+func (r *metricAlertRuleResource) sourceRule(ctx context.Context, data *metricAlertRuleResourceData) (*axonopsClient.MetricAlertRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules, err := r.client.GetAlertRules(ctx, data.SourceClusterType.ValueString(), data.SourceClusterName.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read source alert rules: %s", err))
+		return nil, diags
+	}
+
+	for _, rule := range rules {
+		if rule.ID == data.SourceRuleID.ValueString() {
+			return &rule, diags
+		}
+	}
+
+	diags.AddError(
+		"Source Rule Not Found",
+		fmt.Sprintf("Alert rule %s not found in cluster %s/%s", data.SourceRuleID.ValueString(), data.SourceClusterType.ValueString(), data.SourceClusterName.ValueString()),
+	)
+	return nil, diags
+}
+
+// ModifyPlan copies the attributes that aren't configured from the source rule when a
+// cloned rule is created, so the plan shows the values the rule is created with.
+func (r *metricAlertRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Cloning only applies on create
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planData, config metricAlertRuleResourceData
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planData.SourceRuleID.IsNull() {
+		return
+	}
+
+	planData.unsetUnconfigured(&config)
+
+	// A source that's only known at apply time is cloned in Create
+	if r.client != nil && !planData.SourceRuleID.IsUnknown() && !planData.SourceClusterName.IsUnknown() && !planData.SourceClusterType.IsUnknown() {
+		source, diags := r.sourceRule(ctx, &planData)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(planData.cloneFrom(ctx, source)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
+}
+
 func (r *metricAlertRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data metricAlertRuleResourceData
 
@@ -235,18 +617,43 @@ func (r *metricAlertRuleResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Copy what the plan couldn't resolve from the source rule
+	if !data.SourceRuleID.IsNull() {
+		source, diags := r.sourceRule(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(data.cloneFrom(ctx, source)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	newID := uuid.New().String()
 	data.ID = types.StringValue(newID)
+	data.setNamespace()
+	data.setSummary()
 
 	filters := r.buildFilters(ctx, &data)
 	rule := r.buildRule(&data, filters)
 
-	err := r.client.CreateOrUpdateAlertRule(data.ClusterType.ValueString(), data.ClusterName.ValueString(), rule)
+	err := r.client.CreateOrUpdateAlertRule(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), rule)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert rule: %s", err))
 		return
 	}
 
+	// New rules are enabled, so only a disabled rule needs the toggle
+	if !data.Enabled.ValueBool() {
+		err = r.client.SetAlertRuleEnabled(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), newID, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable alert rule: %s", err))
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Created metric alert rule resource")
 
 	diags = resp.State.Set(ctx, &data)
@@ -262,7 +669,7 @@ func (r *metricAlertRuleResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	rules, err := r.client.GetAlertRules(data.ClusterType.ValueString(), data.ClusterName.ValueString())
+	rules, err := r.client.GetAlertRules(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert rules: %s", err))
 		return
@@ -284,23 +691,20 @@ func (r *metricAlertRuleResource) Read(ctx context.Context, req resource.ReadReq
 
 	data.Name = types.StringValue(found.Alert)
 	data.Metric = types.StringValue(found.Expr)
+	// A configured kafka namespace can't be derived from the metric and is kept
+	if namespace := metricNamespace(found.Expr); !namespace.IsNull() || data.Namespace.ValueString() != "kafka" {
+		data.Namespace = namespace
+	}
 	data.Operator = types.StringValue(found.Operator)
 	data.WarningValue = types.Float64Value(found.WarningValue)
 	data.CriticalValue = types.Float64Value(found.CriticalValue)
 	data.Duration = types.StringValue(found.For)
 	data.Description = types.StringValue(found.Annotations.Description)
+	data.Summary = types.StringValue(found.Annotations.Summary)
+	data.Enabled = types.BoolValue(found.IsEnabled())
 
 	// Parse filters
-	filterMap := map[string]*types.List{
-		"dc":          &data.Dc,
-		"rack":        &data.Rack,
-		"host_id":     &data.HostId,
-		"scope":       &data.Scope,
-		"keyspace":    &data.Keyspace,
-		"percentile":  &data.Percentile,
-		"consistency": &data.Consistency,
-		"groupBy":     &data.GroupBy,
-	}
+	filterMap := data.filterFields()
 
 	// Reset all filters to empty
 	emptyList, _ := types.ListValueFrom(ctx, types.StringType, []string{})
@@ -334,16 +738,26 @@ func (r *metricAlertRuleResource) Update(ctx context.Context, req resource.Updat
 
 	// Keep the same ID
 	planData.ID = stateData.ID
+	planData.setNamespace()
+	planData.setSummary()
 
 	filters := r.buildFilters(ctx, &planData)
 	rule := r.buildRule(&planData, filters)
 
-	err := r.client.CreateOrUpdateAlertRule(planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), rule)
+	err := r.client.CreateOrUpdateAlertRule(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), rule)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert rule: %s", err))
 		return
 	}
 
+	if !planData.Enabled.Equal(stateData.Enabled) || !planData.Enabled.ValueBool() {
+		err = r.client.SetAlertRuleEnabled(ctx, planData.ClusterType.ValueString(), planData.ClusterName.ValueString(), planData.ID.ValueString(), planData.Enabled.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set alert rule enabled state: %s", err))
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Updated metric alert rule resource")
 
 	diags = resp.State.Set(ctx, &planData)
@@ -359,7 +773,7 @@ func (r *metricAlertRuleResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.client.DeleteAlertRule(data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
+	err := r.client.DeleteAlertRule(ctx, data.ClusterType.ValueString(), data.ClusterName.ValueString(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert rule: %s", err))
 		return
@@ -384,7 +798,7 @@ func (r *metricAlertRuleResource) ImportState(ctx context.Context, req resource.
 	clusterName := parts[1]
 	alertID := parts[2]
 
-	rules, err := r.client.GetAlertRules(clusterType, clusterName)
+	rules, err := r.client.GetAlertRules(ctx, clusterType, clusterName)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read alert rules: %s", err))
 		return
@@ -408,11 +822,14 @@ func (r *metricAlertRuleResource) ImportState(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), found.ID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), found.Alert)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metric"), found.Expr)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), metricNamespace(found.Expr))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("operator"), found.Operator)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("warning_value"), found.WarningValue)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("critical_value"), found.CriticalValue)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("duration"), found.For)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), found.Annotations.Description)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("summary"), found.Annotations.Summary)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("enabled"), found.IsEnabled())...)
 
 	// Parse filters into individual attributes
 	filterMap := map[string]string{
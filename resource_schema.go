@@ -3,19 +3,31 @@ package main
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	axonopsClient "terraform-provider-axonops/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = (*schemaResource)(nil)
 var _ resource.ResourceWithImportState = (*schemaResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*schemaResource)(nil)
+
+// schemaReferenceAttrTypes describes a single references element
+var schemaReferenceAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"subject": types.StringType,
+	"version": types.Int64Type,
+}
 
 type schemaResource struct {
 	client *axonopsClient.AxonopsHttpClient
@@ -76,6 +88,42 @@ func (r *schemaResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:    true,
 				Description: "The version number of the schema.",
 			},
+			"soft_deleted": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the latest version of the subject has been soft deleted outside of Terraform. The next apply registers the schema again.",
+			},
+			"references": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Other subjects this schema depends on, e.g. Protobuf imports or AVRO named types defined in another subject.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The name used to refer to the schema (the import path for Protobuf, the fully qualified type name for AVRO).",
+						},
+						"subject": schema.StringAttribute{
+							Required:    true,
+							Description: "The subject the referenced schema is registered under.",
+						},
+						"version": schema.Int64Attribute{
+							Required:    true,
+							Description: "The version of the referenced schema.",
+						},
+					},
+				},
+			},
+			"force_update": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Skip the Schema Registry compatibility check when the schema changes. Default: false",
+			},
+			"hard_delete": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Permanently remove all versions of the subject on destroy instead of only soft deleting them. Default: false",
+			},
 		},
 	}
 }
@@ -87,6 +135,134 @@ type schemaResourceData struct {
 	SchemaType  types.String `tfsdk:"schema_type"`
 	SchemaId    types.Int64  `tfsdk:"schema_id"`
 	Version     types.Int64  `tfsdk:"version"`
+	SoftDeleted types.Bool   `tfsdk:"soft_deleted"`
+	References  types.List   `tfsdk:"references"`
+	ForceUpdate types.Bool   `tfsdk:"force_update"`
+	HardDelete  types.Bool   `tfsdk:"hard_delete"`
+}
+
+type schemaReferenceData struct {
+	Name    types.String `tfsdk:"name"`
+	Subject types.String `tfsdk:"subject"`
+	Version types.Int64  `tfsdk:"version"`
+}
+
+// buildSchemaReferences converts the references attribute into API references
+func buildSchemaReferences(ctx context.Context, list types.List) ([]axonopsClient.SchemaReference, diag.Diagnostics) {
+	var references []schemaReferenceData
+
+	diags := list.ElementsAs(ctx, &references, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var result []axonopsClient.SchemaReference
+	for _, ref := range references {
+		result = append(result, axonopsClient.SchemaReference{
+			Name:    ref.Name.ValueString(),
+			Subject: ref.Subject.ValueString(),
+			Version: int(ref.Version.ValueInt64()),
+		})
+	}
+
+	return result, diags
+}
+
+// flattenSchemaReferences converts API references into the references attribute.
+// No references gives a null list so an unset attribute doesn't show a diff.
+func flattenSchemaReferences(ctx context.Context, references []axonopsClient.SchemaReference) (types.List, diag.Diagnostics) {
+	if len(references) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: schemaReferenceAttrTypes}), nil
+	}
+
+	elements := []schemaReferenceData{}
+	for _, ref := range references {
+		elements = append(elements, schemaReferenceData{
+			Name:    types.StringValue(ref.Name),
+			Subject: types.StringValue(ref.Subject),
+			Version: types.Int64Value(int64(ref.Version)),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemaReferenceAttrTypes}, elements)
+}
+
+// ModifyPlan checks a changed schema against the subject's compatibility
+// settings so incompatible updates are rejected at plan time.
+func (r *schemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planData, stateData schemaResourceData
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Plan an update for a soft deleted schema so apply registers it again
+	if stateData.SoftDeleted.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("soft_deleted"), false)...)
+	}
+
+	if planData.ForceUpdate.ValueBool() || r.client == nil {
+		return
+	}
+
+	if planData.Schema.IsUnknown() || planData.Schema.Equal(stateData.Schema) {
+		return
+	}
+
+	references, diags := buildSchemaReferences(ctx, planData.References)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemaReq := axonopsClient.CreateSchemaRequest{
+		Schema:     planData.Schema.ValueString(),
+		SchemaType: planData.SchemaType.ValueString(),
+		References: references,
+	}
+
+	result, err := r.client.CheckSchemaCompatibility(ctx, planData.ClusterName.ValueString(), planData.Subject.ValueString(), schemaReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check schema compatibility, got error: %s", err))
+		return
+	}
+
+	if result != nil && !result.IsCompatible {
+		message := "The new schema is not compatible with the latest registered version."
+		if len(result.Messages) > 0 {
+			message = fmt.Sprintf("%s\n\n%s", message, strings.Join(result.Messages, "\n"))
+		}
+		message += "\n\nSet force_update = true to skip this check."
+
+		resp.Diagnostics.AddAttributeError(path.Root("schema"), "Incompatible Schema", message)
+	}
+}
+
+// schemaVersion looks up the version a schema ID is registered as in the subject. Posting
+// a schema that is already registered returns its existing ID, which need not be the
+// latest version of the subject.
+func (r *schemaResource) schemaVersion(ctx context.Context, clusterName, subject string, id int) (types.Int64, error) {
+	versions, err := r.client.GetSchemaIDVersions(ctx, clusterName, id)
+	if err != nil {
+		return types.Int64Null(), err
+	}
+
+	// The same schema can be registered more than once in a subject, the latest counts
+	version := types.Int64Null()
+	for _, v := range versions {
+		if v.Subject == subject && (version.IsNull() || int64(v.Version) > version.ValueInt64()) {
+			version = types.Int64Value(int64(v.Version))
+		}
+	}
+	return version, nil
 }
 
 func (r *schemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -99,12 +275,19 @@ func (r *schemaResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	references, diags := buildSchemaReferences(ctx, data.References)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	schemaReq := axonopsClient.CreateSchemaRequest{
 		Schema:     data.Schema.ValueString(),
 		SchemaType: data.SchemaType.ValueString(),
+		References: references,
 	}
 
-	result, err := r.client.CreateSchema(data.ClusterName.ValueString(), data.Subject.ValueString(), schemaReq)
+	result, err := r.client.CreateSchema(ctx, data.ClusterName.ValueString(), data.Subject.ValueString(), schemaReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schema, got error: %s", err))
 		return
@@ -113,16 +296,13 @@ func (r *schemaResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Set the schema ID from the response
 	data.SchemaId = types.Int64Value(int64(result.Id))
 
-	// Read back to get the version
-	schemaInfo, err := r.client.GetSchema(data.ClusterName.ValueString(), data.Subject.ValueString(), "latest")
+	version, err := r.schemaVersion(ctx, data.ClusterName.ValueString(), data.Subject.ValueString(), result.Id)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema after creation, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema version after creation, got error: %s", err))
 		return
 	}
-
-	if schemaInfo != nil {
-		data.Version = types.Int64Value(int64(schemaInfo.Version))
-	}
+	data.Version = version
+	data.SoftDeleted = types.BoolValue(false)
 
 	tflog.Info(ctx, "Created schema resource")
 
@@ -140,7 +320,14 @@ func (r *schemaResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	result, err := r.client.GetSchema(data.ClusterName.ValueString(), data.Subject.ValueString(), "latest")
+	// Read the version this resource registered, which need not be the latest when the
+	// schema was already registered or other versions were added outside of Terraform
+	version := "latest"
+	if !data.Version.IsNull() && !data.Version.IsUnknown() {
+		version = strconv.FormatInt(data.Version.ValueInt64(), 10)
+	}
+
+	result, err := r.client.GetSchema(ctx, data.ClusterName.ValueString(), data.Subject.ValueString(), version)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema, got error: %s", err))
 		return
@@ -152,11 +339,32 @@ func (r *schemaResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	// Only update computed fields from API
-	// Don't update Schema or SchemaType as the API returns minified JSON
-	// which would cause unnecessary diffs with formatted Terraform config
 	data.SchemaId = types.Int64Value(int64(result.Id))
 	data.Version = types.Int64Value(int64(result.Version))
+	data.SoftDeleted = types.BoolValue(result.IsSoftDeleted)
+
+	if result.IsSoftDeleted {
+		resp.Diagnostics.AddWarning(
+			"Schema Soft Deleted",
+			fmt.Sprintf("Version %d of subject %s has been soft deleted outside of Terraform. Run terraform apply to register the schema again.", result.Version, data.Subject.ValueString()),
+		)
+	}
+
+	// The API returns minified schemas, so only take the API value when it
+	// differs from state in more than formatting. If either side can't be
+	// normalised there is no reliable comparison, and the state value is kept.
+	if data.Schema.IsNull() {
+		data.Schema = types.StringValue(result.Schema)
+	} else {
+		stateSchema, stateErr := axonopsClient.NormalizeSchemaString(data.Schema.ValueString(), data.SchemaType.ValueString())
+		apiSchema, apiErr := axonopsClient.NormalizeSchemaString(result.Schema, data.SchemaType.ValueString())
+		if stateErr == nil && apiErr == nil && stateSchema != apiSchema {
+			data.Schema = types.StringValue(result.Schema)
+		}
+	}
+
+	data.References, diags = flattenSchemaReferences(ctx, result.References)
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -174,12 +382,19 @@ func (r *schemaResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Schema Registry allows posting new versions to the same subject
 	// This creates a new version of the schema
+	references, diags := buildSchemaReferences(ctx, planData.References)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	schemaReq := axonopsClient.CreateSchemaRequest{
 		Schema:     planData.Schema.ValueString(),
 		SchemaType: planData.SchemaType.ValueString(),
+		References: references,
 	}
 
-	result, err := r.client.CreateSchema(planData.ClusterName.ValueString(), planData.Subject.ValueString(), schemaReq)
+	result, err := r.client.CreateSchema(ctx, planData.ClusterName.ValueString(), planData.Subject.ValueString(), schemaReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema, got error: %s", err))
 		return
@@ -188,16 +403,13 @@ func (r *schemaResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Set the new schema ID
 	planData.SchemaId = types.Int64Value(int64(result.Id))
 
-	// Read back to get the new version
-	schemaInfo, err := r.client.GetSchema(planData.ClusterName.ValueString(), planData.Subject.ValueString(), "latest")
+	version, err := r.schemaVersion(ctx, planData.ClusterName.ValueString(), planData.Subject.ValueString(), result.Id)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema after update, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema version after update, got error: %s", err))
 		return
 	}
-
-	if schemaInfo != nil {
-		planData.Version = types.Int64Value(int64(schemaInfo.Version))
-	}
+	planData.Version = version
+	planData.SoftDeleted = types.BoolValue(false)
 
 	tflog.Info(ctx, "Updated schema resource")
 
@@ -215,12 +427,21 @@ func (r *schemaResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteSchema(data.ClusterName.ValueString(), data.Subject.ValueString())
+	err := r.client.DeleteSchema(ctx, data.ClusterName.ValueString(), data.Subject.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schema, got error: %s", err))
 		return
 	}
 
+	// A permanent delete only applies to already soft deleted subjects
+	if data.HardDelete.ValueBool() {
+		err = r.client.HardDeleteSchema(ctx, data.ClusterName.ValueString(), data.Subject.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to permanently delete schema, got error: %s", err))
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Deleted schema resource")
 }
 
@@ -241,7 +462,7 @@ func (r *schemaResource) ImportState(ctx context.Context, req resource.ImportSta
 	subject := parts[1]
 
 	// Get schema details from the API
-	schemaInfo, err := r.client.GetSchema(clusterName, subject, "latest")
+	schemaInfo, err := r.client.GetSchema(ctx, clusterName, subject, "latest")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Error",
@@ -258,6 +479,9 @@ func (r *schemaResource) ImportState(ctx context.Context, req resource.ImportSta
 		return
 	}
 
+	references, diags := flattenSchemaReferences(ctx, schemaInfo.References)
+	resp.Diagnostics.Append(diags...)
+
 	// Set the state
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject"), subject)...)
@@ -265,6 +489,10 @@ func (r *schemaResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schema_type"), schemaInfo.Type)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schema_id"), int64(schemaInfo.Id))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), int64(schemaInfo.Version))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("soft_deleted"), schemaInfo.IsSoftDeleted)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("references"), references)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_update"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hard_delete"), false)...)
 
 	tflog.Info(ctx, fmt.Sprintf("Imported schema %s from cluster %s", subject, clusterName))
 }
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*schemaCompatibilityResource)(nil)
+var _ resource.ResourceWithImportState = (*schemaCompatibilityResource)(nil)
+
+type schemaCompatibilityResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewSchemaCompatibilityResource() resource.Resource {
+	return &schemaCompatibilityResource{}
+}
+
+func (r *schemaCompatibilityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *schemaCompatibilityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_compatibility"
+}
+
+func (r *schemaCompatibilityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Schema Registry compatibility level, either globally or for a single subject.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+			},
+			"subject": schema.StringAttribute{
+				Optional:    true,
+				Description: "The subject to override. When omitted, the global compatibility level is managed, which is the same setting as default_compatibility_level of axonops_schema_registry_config; don't manage it with both resources.",
+			},
+			"compatibility_level": schema.StringAttribute{
+				Required:    true,
+				Description: "The compatibility level. Valid values: NONE, BACKWARD, BACKWARD_TRANSITIVE, FORWARD, FORWARD_TRANSITIVE, FULL, FULL_TRANSITIVE.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("NONE", "BACKWARD", "BACKWARD_TRANSITIVE", "FORWARD", "FORWARD_TRANSITIVE", "FULL", "FULL_TRANSITIVE"),
+				},
+			},
+		},
+	}
+}
+
+type schemaCompatibilityResourceData struct {
+	ClusterName        types.String `tfsdk:"cluster_name"`
+	Subject            types.String `tfsdk:"subject"`
+	CompatibilityLevel types.String `tfsdk:"compatibility_level"`
+}
+
+func (r *schemaCompatibilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data schemaCompatibilityResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetSchemaCompatibility(ctx, data.ClusterName.ValueString(), data.Subject.ValueString(), data.CompatibilityLevel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set schema compatibility: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Created schema compatibility resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaCompatibilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data schemaCompatibilityResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	level, err := r.client.GetSchemaCompatibility(ctx, data.ClusterName.ValueString(), data.Subject.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema compatibility: %s", err))
+		return
+	}
+
+	if level == "" {
+		// Subject override was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.CompatibilityLevel = types.StringValue(level)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaCompatibilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, stateData schemaCompatibilityResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &stateData)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetSchemaCompatibility(ctx, data.ClusterName.ValueString(), data.Subject.ValueString(), data.CompatibilityLevel.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set schema compatibility: %s", err))
+		return
+	}
+
+	// Remove the override from the previous subject when the resource moved
+	moved := stateData.ClusterName.ValueString() != data.ClusterName.ValueString() || stateData.Subject.ValueString() != data.Subject.ValueString()
+	if moved && stateData.Subject.ValueString() != "" {
+		err = r.client.DeleteSchemaCompatibility(ctx, stateData.ClusterName.ValueString(), stateData.Subject.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to remove compatibility override from subject %s: %s", stateData.Subject.ValueString(), err))
+		}
+	}
+
+	tflog.Info(ctx, "Updated schema compatibility resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaCompatibilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data schemaCompatibilityResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The global level can't be removed, so it is left as is
+	if data.Subject.ValueString() == "" {
+		tflog.Info(ctx, "Removed global schema compatibility resource from state, the registry setting is unchanged")
+		return
+	}
+
+	err := r.client.DeleteSchemaCompatibility(ctx, data.ClusterName.ValueString(), data.Subject.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schema compatibility: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted schema compatibility resource")
+}
+
+// ImportState imports an existing compatibility setting.
+// Import ID format: cluster_name for the global level, or cluster_name/subject
+func (r *schemaCompatibilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterName, subject, _ := strings.Cut(req.ID, "/")
+	if clusterName == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_name or cluster_name/subject, got: %s", req.ID),
+		)
+		return
+	}
+
+	level, err := r.client.GetSchemaCompatibility(ctx, clusterName, subject)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read schema compatibility: %s", err))
+		return
+	}
+
+	if level == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("No compatibility override found for subject %s", subject))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	if subject != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject"), subject)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("compatibility_level"), level)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported schema compatibility for %s", req.ID))
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*schemaNamingStrategyResource)(nil)
+var _ resource.ResourceWithImportState = (*schemaNamingStrategyResource)(nil)
+
+// defaultSchemaNamingStrategy is the Schema Registry default, restored when the resource is destroyed
+const defaultSchemaNamingStrategy = "TopicNameStrategy"
+
+type schemaNamingStrategyResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewSchemaNamingStrategyResource() resource.Resource {
+	return &schemaNamingStrategyResource{}
+}
+
+func (r *schemaNamingStrategyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *schemaNamingStrategyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_naming_strategy"
+}
+
+func (r *schemaNamingStrategyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the subject naming strategy of the Schema Registry of a cluster. Use a single resource per cluster. Destroying the resource resets the strategy to TopicNameStrategy.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"strategy": schema.StringAttribute{
+				Required:    true,
+				Description: "The subject naming strategy. Valid values: TopicNameStrategy, RecordNameStrategy, TopicRecordNameStrategy.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("TopicNameStrategy", "RecordNameStrategy", "TopicRecordNameStrategy"),
+				},
+			},
+		},
+	}
+}
+
+type schemaNamingStrategyResourceData struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Strategy    types.String `tfsdk:"strategy"`
+}
+
+func (r *schemaNamingStrategyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data schemaNamingStrategyResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetSchemaNamingStrategy(ctx, data.ClusterName.ValueString(), data.Strategy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set schema naming strategy: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Created schema naming strategy resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaNamingStrategyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data schemaNamingStrategyResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	strategy, err := r.client.GetSchemaNamingStrategy(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema naming strategy: %s", err))
+		return
+	}
+
+	data.Strategy = types.StringValue(strategy)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaNamingStrategyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data schemaNamingStrategyResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetSchemaNamingStrategy(ctx, data.ClusterName.ValueString(), data.Strategy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set schema naming strategy: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Updated schema naming strategy resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaNamingStrategyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data schemaNamingStrategyResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetSchemaNamingStrategy(ctx, data.ClusterName.ValueString(), defaultSchemaNamingStrategy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset schema naming strategy: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted (reset) schema naming strategy resource")
+}
+
+// ImportState imports the naming strategy of a cluster.
+// Import ID format: cluster_name
+func (r *schemaNamingStrategyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	strategy, err := r.client.GetSchemaNamingStrategy(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read schema naming strategy: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("strategy"), strategy)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported schema naming strategy from cluster %s", req.ID))
+}
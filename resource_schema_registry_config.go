@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*schemaRegistryConfigResource)(nil)
+var _ resource.ResourceWithImportState = (*schemaRegistryConfigResource)(nil)
+
+// Schema Registry defaults, which Delete restores
+const (
+	defaultSchemaCompatibilityLevel = "BACKWARD"
+	defaultSchemaRegistryMode       = "READWRITE"
+)
+
+type schemaRegistryConfigResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewSchemaRegistryConfigResource() resource.Resource {
+	return &schemaRegistryConfigResource{}
+}
+
+func (r *schemaRegistryConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *schemaRegistryConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_registry_config"
+}
+
+func (r *schemaRegistryConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the global Schema Registry settings of a Kafka cluster. Destroying the resource resets them to BACKWARD compatibility and READWRITE mode. default_compatibility_level is the same setting as an axonops_schema_compatibility resource without a subject, so don't manage the global compatibility level with both.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Kafka cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_compatibility_level": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultSchemaCompatibilityLevel),
+				Description: "The compatibility level of subjects without an override. Valid values: NONE, BACKWARD, BACKWARD_TRANSITIVE, FORWARD, FORWARD_TRANSITIVE, FULL, FULL_TRANSITIVE. Default: BACKWARD",
+				Validators: []validator.String{
+					stringvalidator.OneOf("NONE", "BACKWARD", "BACKWARD_TRANSITIVE", "FORWARD", "FORWARD_TRANSITIVE", "FULL", "FULL_TRANSITIVE"),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultSchemaRegistryMode),
+				Description: "The registry mode. Valid values: IMPORT, READONLY, READWRITE. Default: READWRITE",
+				Validators: []validator.String{
+					stringvalidator.OneOf("IMPORT", "READONLY", "READWRITE"),
+				},
+			},
+		},
+	}
+}
+
+type schemaRegistryConfigResourceData struct {
+	ClusterName               types.String `tfsdk:"cluster_name"`
+	DefaultCompatibilityLevel types.String `tfsdk:"default_compatibility_level"`
+	Mode                      types.String `tfsdk:"mode"`
+}
+
+func (r *schemaRegistryConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data schemaRegistryConfigResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateSchemaRegistryConfig(ctx, data.ClusterName.ValueString(), axonopsClient.SchemaRegistryConfig{
+		CompatibilityLevel: data.DefaultCompatibilityLevel.ValueString(),
+		Mode:               data.Mode.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema registry config: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Created schema registry config resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaRegistryConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data schemaRegistryConfigResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.GetSchemaRegistryConfig(ctx, data.ClusterName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schema registry config: %s", err))
+		return
+	}
+
+	data.DefaultCompatibilityLevel = types.StringValue(config.CompatibilityLevel)
+	data.Mode = types.StringValue(config.Mode)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaRegistryConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data schemaRegistryConfigResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateSchemaRegistryConfig(ctx, data.ClusterName.ValueString(), axonopsClient.SchemaRegistryConfig{
+		CompatibilityLevel: data.DefaultCompatibilityLevel.ValueString(),
+		Mode:               data.Mode.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schema registry config: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Updated schema registry config resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaRegistryConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data schemaRegistryConfigResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The global settings can't be removed, so they are reset to the registry defaults
+	err := r.client.UpdateSchemaRegistryConfig(ctx, data.ClusterName.ValueString(), axonopsClient.SchemaRegistryConfig{
+		CompatibilityLevel: defaultSchemaCompatibilityLevel,
+		Mode:               defaultSchemaRegistryMode,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset schema registry config: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted (reset) schema registry config resource")
+}
+
+// ImportState imports the current registry settings.
+// Import ID format: cluster_name
+func (r *schemaRegistryConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_name, got: %s", req.ID),
+		)
+		return
+	}
+
+	config, err := r.client.GetSchemaRegistryConfig(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read schema registry config: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("default_compatibility_level"), config.CompatibilityLevel)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), config.Mode)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported schema registry config for %s", req.ID))
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = (*schemaSubjectModeResource)(nil)
+var _ resource.ResourceWithImportState = (*schemaSubjectModeResource)(nil)
+
+type schemaSubjectModeResource struct {
+	client *axonopsClient.AxonopsHttpClient
+}
+
+func NewSchemaSubjectModeResource() resource.Resource {
+	return &schemaSubjectModeResource{}
+}
+
+func (r *schemaSubjectModeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*axonopsClient.AxonopsHttpClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *axonopsClient.AxonopsHttpClient, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *schemaSubjectModeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_subject_mode"
+}
+
+func (r *schemaSubjectModeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The override belongs to the subject, so moving it recreates it
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the Schema Registry mode of a single subject. Destroying the resource removes the override so the subject falls back to the global mode.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the Kafka cluster.",
+				PlanModifiers: replaceString,
+			},
+			"subject": schema.StringAttribute{
+				Required:      true,
+				Description:   "The subject to override.",
+				PlanModifiers: replaceString,
+			},
+			"mode": schema.StringAttribute{
+				Required:    true,
+				Description: "The mode of the subject. Valid values: READWRITE, READONLY, IMPORT.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("READWRITE", "READONLY", "IMPORT"),
+				},
+			},
+		},
+	}
+}
+
+type schemaSubjectModeResourceData struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Subject     types.String `tfsdk:"subject"`
+	Mode        types.String `tfsdk:"mode"`
+}
+
+func (r *schemaSubjectModeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data schemaSubjectModeResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetSubjectMode(ctx, data.ClusterName.ValueString(), data.Subject.ValueString(), data.Mode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set subject mode: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Created schema subject mode resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaSubjectModeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data schemaSubjectModeResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mode, err := r.client.GetSubjectMode(ctx, data.ClusterName.ValueString(), data.Subject.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subject mode: %s", err))
+		return
+	}
+
+	if mode == "" {
+		// Subject override was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Mode = types.StringValue(mode)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaSubjectModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data schemaSubjectModeResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetSubjectMode(ctx, data.ClusterName.ValueString(), data.Subject.ValueString(), data.Mode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set subject mode: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Updated schema subject mode resource")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *schemaSubjectModeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data schemaSubjectModeResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSubjectMode(ctx, data.ClusterName.ValueString(), data.Subject.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subject mode: %s", err))
+		return
+	}
+
+	tflog.Info(ctx, "Deleted schema subject mode resource")
+}
+
+// ImportState imports an existing subject mode override.
+// Import ID format: cluster_name/subject
+func (r *schemaSubjectModeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterName, subject, _ := strings.Cut(req.ID, "/")
+	if clusterName == "" || subject == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: cluster_name/subject, got: %s", req.ID),
+		)
+		return
+	}
+
+	mode, err := r.client.GetSubjectMode(ctx, clusterName, subject)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("Unable to read subject mode: %s", err))
+		return
+	}
+
+	if mode == "" {
+		resp.Diagnostics.AddError("Import Error", fmt.Sprintf("No mode override found for subject %s", subject))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), clusterName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject"), subject)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), mode)...)
+
+	tflog.Info(ctx, fmt.Sprintf("Imported schema subject mode for %s", req.ID))
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	axonopsClient "terraform-provider-axonops/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	testSchemaV1 = `{"type":"record","name":"User","fields":[{"name":"id","type":"string"}]}`
+	testSchemaV2 = `{"type":"record","name":"User","fields":[{"name":"id","type":"int"}]}`
+)
+
+func testSchemaData(schemaValue string, forceUpdate bool) schemaResourceData {
+	return schemaResourceData{
+		ClusterName: types.StringValue("prod"),
+		Subject:     types.StringValue("users-value"),
+		Schema:      types.StringValue(schemaValue),
+		SchemaType:  types.StringValue("AVRO"),
+		SchemaId:    types.Int64Value(1),
+		Version:     types.Int64Value(1),
+		SoftDeleted: types.BoolValue(false),
+		References:  types.ListNull(types.ObjectType{AttrTypes: schemaReferenceAttrTypes}),
+		ForceUpdate: types.BoolValue(forceUpdate),
+		HardDelete:  types.BoolValue(false),
+	}
+}
+
+// modifySchemaPlan plans a change from prior to planned against a registry that answers
+// compatibility checks with response, and returns the diagnostics and the number of checks
+func modifySchemaPlan(t *testing.T, prior, planned schemaResourceData, response axonopsClient.SchemaCompatibilityResponse) (resource.ModifyPlanResponse, int) {
+	t.Helper()
+
+	checks := 0
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/test-org/kafka/prod/registry/compatibility/subjects/users-value/versions" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		checks++
+
+		var body axonopsClient.CreateSchemaRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("invalid request body: %s", err)
+		}
+		if body.Schema != planned.Schema.ValueString() {
+			t.Errorf("checked schema %s, want %s", body.Schema, planned.Schema.ValueString())
+		}
+
+		json.NewEncoder(w).Encode(response)
+	}))
+
+	r := &schemaResource{client: client}
+	s := resourceSchema(t, r)
+	plan := newTestPlan(t, s, &planned)
+
+	req := resource.ModifyPlanRequest{
+		State: newTestState(t, s, &prior),
+		Plan:  plan,
+	}
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(context.Background(), req, &resp)
+
+	return resp, checks
+}
+
+func TestSchemaModifyPlanIncompatible(t *testing.T) {
+	resp, checks := modifySchemaPlan(t, testSchemaData(testSchemaV1, false), testSchemaData(testSchemaV2, false), axonopsClient.SchemaCompatibilityResponse{
+		IsCompatible: false,
+		Messages:     []string{"reader type: INT not compatible with writer type: STRING"},
+	})
+
+	if checks != 1 {
+		t.Fatalf("compatibility checked %d times, want 1", checks)
+	}
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected one error, got: %v", resp.Diagnostics)
+	}
+
+	errDiag := resp.Diagnostics.Errors()[0]
+	withPath, ok := errDiag.(interface{ Path() path.Path })
+	if !ok || !withPath.Path().Equal(path.Root("schema")) {
+		t.Errorf("error is not on the schema attribute: %v", errDiag)
+	}
+	if !strings.Contains(errDiag.Detail(), "reader type: INT not compatible with writer type: STRING") {
+		t.Errorf("error does not include the incompatibility: %s", errDiag.Detail())
+	}
+}
+
+func TestSchemaModifyPlanCompatible(t *testing.T) {
+	resp, checks := modifySchemaPlan(t, testSchemaData(testSchemaV1, false), testSchemaData(testSchemaV2, false), axonopsClient.SchemaCompatibilityResponse{
+		IsCompatible: true,
+	})
+
+	if checks != 1 {
+		t.Errorf("compatibility checked %d times, want 1", checks)
+	}
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected error: %v", resp.Diagnostics)
+	}
+}
+
+func TestSchemaModifyPlanForceUpdateSkipsCheck(t *testing.T) {
+	resp, checks := modifySchemaPlan(t, testSchemaData(testSchemaV1, false), testSchemaData(testSchemaV2, true), axonopsClient.SchemaCompatibilityResponse{
+		IsCompatible: false,
+	})
+
+	if checks != 0 {
+		t.Errorf("compatibility checked %d times with force_update, want 0", checks)
+	}
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected error: %v", resp.Diagnostics)
+	}
+}
+
+func TestSchemaModifyPlanUnchangedSchemaSkipsCheck(t *testing.T) {
+	prior := testSchemaData(testSchemaV1, false)
+	planned := testSchemaData(testSchemaV1, false)
+	planned.HardDelete = types.BoolValue(true)
+
+	resp, checks := modifySchemaPlan(t, prior, planned, axonopsClient.SchemaCompatibilityResponse{
+		IsCompatible: false,
+	})
+
+	if checks != 0 {
+		t.Errorf("compatibility checked %d times without a schema change, want 0", checks)
+	}
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected error: %v", resp.Diagnostics)
+	}
+}
+
+func TestSchemaReadKeepsRegisteredVersion(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Version 3 was added outside of Terraform, this resource registered version 1
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/test-org/kafka/prod/registry/subjects/users-value/1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(axonopsClient.SchemaRegistryVersionedSchema{Id: 7, Version: 1, Schema: testSchemaV1, Type: "AVRO"})
+	}))
+	r := &schemaResource{client: client}
+	s := resourceSchema(t, r)
+
+	prior := testSchemaData(testSchemaV1, false)
+	prior.SchemaId = types.Int64Value(7)
+	resp := resource.ReadResponse{State: newTestState(t, s, &prior)}
+	r.Read(context.Background(), resource.ReadRequest{State: newTestState(t, s, &prior)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state schemaResourceData
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+	if state.SchemaId.ValueInt64() != 7 || state.Version.ValueInt64() != 1 {
+		t.Errorf("schema_id = %s, version = %s, want 7 and 1", state.SchemaId, state.Version)
+	}
+}
@@ -78,6 +78,11 @@ variable "axonops_api_key" {{
         f.write(content)
 
 
+# Topic configs managed by dedicated axonops_kafka_topic attributes
+TOPIC_NUMERIC_ATTRIBUTES = {'min_cleanable_dirty_ratio', 'delete_retention_ms', 'min_insync_replicas', 'retention_ms'}
+TOPIC_STRING_ATTRIBUTES = {'cleanup_policy'}
+
+
 def import_topics(api_base: str, api_key: str, cluster_name: str, output_dir: str) -> list[str]:
     """Import topics and return import commands."""
     print("Fetching topics...")
@@ -107,6 +112,7 @@ def import_topics(api_base: str, api_key: str, cluster_name: str, output_dir: st
         config_data = make_request(config_url, api_key)
 
         config_lines = []
+        attribute_lines = []
         if config_data:
             topic_desc = config_data.get('topicDescription', [])
             if topic_desc and len(topic_desc) > 0:
@@ -116,7 +122,12 @@ def import_topics(api_base: str, api_key: str, cluster_name: str, output_dir: st
                         # Convert dots to underscores for Terraform
                         key = entry.get('name', '').replace('.', '_')
                         value = escape_hcl_string(entry.get('value', ''))
-                        config_lines.append(f'    {key} = "{value}"')
+                        if key in TOPIC_NUMERIC_ATTRIBUTES:
+                            attribute_lines.append(f'  {key} = {value}')
+                        elif key in TOPIC_STRING_ATTRIBUTES:
+                            attribute_lines.append(f'  {key} = "{value}"')
+                        else:
+                            config_lines.append(f'    {key} = "{value}"')
 
         resource = f'''resource "axonops_kafka_topic" "{safe_name}" {{
   name               = "{name}"
@@ -124,6 +135,9 @@ def import_topics(api_base: str, api_key: str, cluster_name: str, output_dir: st
   replication_factor = {replication}
   cluster_name       = "{cluster_name}"'''
 
+        if attribute_lines:
+            resource += '\n' + '\n'.join(attribute_lines)
+
         if config_lines:
             resource += '\n  config = {\n'
             resource += '\n'.join(config_lines)